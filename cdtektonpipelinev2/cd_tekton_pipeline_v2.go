@@ -28,6 +28,7 @@ import (
 	"net/http"
 	"reflect"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	common "github.com/IBM/continuous-delivery-go-sdk/v2/common"
@@ -43,6 +44,10 @@ import (
 // API Version: 2.0.0
 type CdTektonPipelineV2 struct {
 	Service *core.BaseService
+
+	// Watches the pipeline runs created through the service, if set with EnablePipelineRunEvents.
+	// Shared with the clones of the service.
+	pipelineRunEvents *atomic.Pointer[PipelineRunEvents]
 }
 
 // DefaultServiceURL is the default URL to make service requests to.
@@ -56,6 +61,15 @@ type CdTektonPipelineV2Options struct {
 	ServiceName   string
 	URL           string
 	Authenticator core.Authenticator
+
+	// Limits the rate and concurrency of the requests sent by the service instance and its clones.
+	RateLimiter *common.RateLimiter
+
+	// Caches the responses of GetTektonPipeline for the service instance and its clones.
+	ResponseCache *common.ResponseCache
+
+	// Traces, measures and logs the operations of the service instance and its clones.
+	Telemetry *common.Telemetry
 }
 
 // NewCdTektonPipelineV2UsingExternalConfig : constructs an instance of CdTektonPipelineV2 with passed in options and external configuration.
@@ -78,7 +92,7 @@ func NewCdTektonPipelineV2UsingExternalConfig(options *CdTektonPipelineV2Options
 		return
 	}
 
-	err = cdTektonPipeline.Service.ConfigureService(options.ServiceName)
+	err = common.ConfigureService(cdTektonPipeline.Service, options.ServiceName)
 	if err != nil {
 		err = core.SDKErrorf(err, "", "client-config-error", common.GetComponentInfo())
 		return
@@ -113,7 +127,19 @@ func NewCdTektonPipelineV2(options *CdTektonPipelineV2Options) (service *CdTekto
 	}
 
 	service = &CdTektonPipelineV2{
-		Service: baseService,
+		Service:           baseService,
+		pipelineRunEvents: new(atomic.Pointer[PipelineRunEvents]),
+	}
+	service.enableRunEventsHook()
+
+	if options.RateLimiter != nil {
+		common.EnableRateLimiting(service.Service, options.RateLimiter)
+	}
+	if options.ResponseCache != nil {
+		common.EnableResponseCaching(service.Service, options.ResponseCache, isCacheablePath)
+	}
+	if options.Telemetry != nil {
+		common.EnableTelemetry(service.Service, options.Telemetry)
 	}
 
 	return
@@ -190,6 +216,20 @@ func (cdTektonPipeline *CdTektonPipelineV2) DisableRetries() {
 	cdTektonPipeline.Service.DisableRetries()
 }
 
+// DisableSSLVerification skips the verification of server certificates and hostnames for this
+// service instance. Unlike Service.DisableSSLVerification, it also takes effect after features
+// such as debug logging, or the RateLimiter and ResponseCache options, have wrapped the transport
+// of the HTTP client. Service.DisableSSLVerification and Service.IsSSLDisabled have no effect on
+// a wrapped transport.
+func (cdTektonPipeline *CdTektonPipelineV2) DisableSSLVerification() {
+	common.DisableSSLVerification(cdTektonPipeline.Service)
+}
+
+// IsSSLDisabled returns true if this service instance skips the verification of server certificates.
+func (cdTektonPipeline *CdTektonPipelineV2) IsSSLDisabled() bool {
+	return common.IsSSLDisabled(cdTektonPipeline.Service)
+}
+
 // CreateTektonPipeline : Create Tekton pipeline
 // This request creates a Tekton pipeline. Requires a pipeline tool already created in the toolchain using the toolchain
 // API https://cloud.ibm.com/apidocs/toolchain#create-tool, and use the tool ID to create the Tekton pipeline.
@@ -264,7 +304,7 @@ func (cdTektonPipeline *CdTektonPipelineV2) CreateTektonPipelineWithContext(ctx
 	response, err = cdTektonPipeline.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_tekton_pipeline", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = common.ClassifyError(core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo()))
 		return
 	}
 	if rawResponse != nil {
@@ -333,7 +373,7 @@ func (cdTektonPipeline *CdTektonPipelineV2) GetTektonPipelineWithContext(ctx con
 	response, err = cdTektonPipeline.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_tekton_pipeline", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = common.ClassifyError(core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo()))
 		return
 	}
 	if rawResponse != nil {
@@ -412,7 +452,7 @@ func (cdTektonPipeline *CdTektonPipelineV2) UpdateTektonPipelineWithContext(ctx
 	response, err = cdTektonPipeline.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "update_tekton_pipeline", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = common.ClassifyError(core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo()))
 		return
 	}
 	if rawResponse != nil {
@@ -479,7 +519,7 @@ func (cdTektonPipeline *CdTektonPipelineV2) DeleteTektonPipelineWithContext(ctx
 	response, err = cdTektonPipeline.Service.Request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_tekton_pipeline", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = common.ClassifyError(core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo()))
 		return
 	}
 
@@ -554,7 +594,7 @@ func (cdTektonPipeline *CdTektonPipelineV2) ListTektonPipelineRunsWithContext(ct
 	response, err = cdTektonPipeline.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_tekton_pipeline_runs", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = common.ClassifyError(core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo()))
 		return
 	}
 	if rawResponse != nil {
@@ -653,7 +693,7 @@ func (cdTektonPipeline *CdTektonPipelineV2) CreateTektonPipelineRunWithContext(c
 	response, err = cdTektonPipeline.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_tekton_pipeline_run", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = common.ClassifyError(core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo()))
 		return
 	}
 	if rawResponse != nil {
@@ -727,7 +767,7 @@ func (cdTektonPipeline *CdTektonPipelineV2) GetTektonPipelineRunWithContext(ctx
 	response, err = cdTektonPipeline.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_tekton_pipeline_run", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = common.ClassifyError(core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo()))
 		return
 	}
 	if rawResponse != nil {
@@ -795,7 +835,7 @@ func (cdTektonPipeline *CdTektonPipelineV2) DeleteTektonPipelineRunWithContext(c
 	response, err = cdTektonPipeline.Service.Request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_tekton_pipeline_run", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = common.ClassifyError(core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo()))
 		return
 	}
 
@@ -869,7 +909,7 @@ func (cdTektonPipeline *CdTektonPipelineV2) CancelTektonPipelineRunWithContext(c
 	response, err = cdTektonPipeline.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "cancel_tekton_pipeline_run", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = common.ClassifyError(core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo()))
 		return
 	}
 	if rawResponse != nil {
@@ -940,7 +980,7 @@ func (cdTektonPipeline *CdTektonPipelineV2) RerunTektonPipelineRunWithContext(ct
 	response, err = cdTektonPipeline.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "rerun_tekton_pipeline_run", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = common.ClassifyError(core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo()))
 		return
 	}
 	if rawResponse != nil {
@@ -1011,7 +1051,7 @@ func (cdTektonPipeline *CdTektonPipelineV2) GetTektonPipelineRunLogsWithContext(
 	response, err = cdTektonPipeline.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_tekton_pipeline_run_logs", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = common.ClassifyError(core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo()))
 		return
 	}
 	if rawResponse != nil {
@@ -1083,7 +1123,7 @@ func (cdTektonPipeline *CdTektonPipelineV2) GetTektonPipelineRunLogContentWithCo
 	response, err = cdTektonPipeline.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_tekton_pipeline_run_log_content", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = common.ClassifyError(core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo()))
 		return
 	}
 	if rawResponse != nil {
@@ -1156,7 +1196,7 @@ func (cdTektonPipeline *CdTektonPipelineV2) ListTektonPipelineDefinitionsWithCon
 	response, err = cdTektonPipeline.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_tekton_pipeline_definitions", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = common.ClassifyError(core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo()))
 		return
 	}
 	if rawResponse != nil {
@@ -1240,7 +1280,7 @@ func (cdTektonPipeline *CdTektonPipelineV2) CreateTektonPipelineDefinitionWithCo
 	response, err = cdTektonPipeline.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_tekton_pipeline_definition", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = common.ClassifyError(core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo()))
 		return
 	}
 	if rawResponse != nil {
@@ -1311,7 +1351,7 @@ func (cdTektonPipeline *CdTektonPipelineV2) GetTektonPipelineDefinitionWithConte
 	response, err = cdTektonPipeline.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_tekton_pipeline_definition", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = common.ClassifyError(core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo()))
 		return
 	}
 	if rawResponse != nil {
@@ -1392,7 +1432,7 @@ func (cdTektonPipeline *CdTektonPipelineV2) ReplaceTektonPipelineDefinitionWithC
 	response, err = cdTektonPipeline.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "replace_tekton_pipeline_definition", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = common.ClassifyError(core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo()))
 		return
 	}
 	if rawResponse != nil {
@@ -1460,7 +1500,7 @@ func (cdTektonPipeline *CdTektonPipelineV2) DeleteTektonPipelineDefinitionWithCo
 	response, err = cdTektonPipeline.Service.Request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_tekton_pipeline_definition", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = common.ClassifyError(core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo()))
 		return
 	}
 
@@ -1531,7 +1571,7 @@ func (cdTektonPipeline *CdTektonPipelineV2) ListTektonPipelinePropertiesWithCont
 	response, err = cdTektonPipeline.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_tekton_pipeline_properties", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = common.ClassifyError(core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo()))
 		return
 	}
 	if rawResponse != nil {
@@ -1626,7 +1666,7 @@ func (cdTektonPipeline *CdTektonPipelineV2) CreateTektonPipelinePropertiesWithCo
 	response, err = cdTektonPipeline.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_tekton_pipeline_properties", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = common.ClassifyError(core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo()))
 		return
 	}
 	if rawResponse != nil {
@@ -1696,7 +1736,7 @@ func (cdTektonPipeline *CdTektonPipelineV2) GetTektonPipelinePropertyWithContext
 	response, err = cdTektonPipeline.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_tekton_pipeline_property", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = common.ClassifyError(core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo()))
 		return
 	}
 	if rawResponse != nil {
@@ -1793,7 +1833,7 @@ func (cdTektonPipeline *CdTektonPipelineV2) ReplaceTektonPipelinePropertyWithCon
 	response, err = cdTektonPipeline.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "replace_tekton_pipeline_property", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = common.ClassifyError(core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo()))
 		return
 	}
 	if rawResponse != nil {
@@ -1861,7 +1901,7 @@ func (cdTektonPipeline *CdTektonPipelineV2) DeleteTektonPipelinePropertyWithCont
 	response, err = cdTektonPipeline.Service.Request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_tekton_pipeline_property", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = common.ClassifyError(core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo()))
 		return
 	}
 
@@ -1944,7 +1984,7 @@ func (cdTektonPipeline *CdTektonPipelineV2) ListTektonPipelineTriggersWithContex
 	response, err = cdTektonPipeline.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_tekton_pipeline_triggers", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = common.ClassifyError(core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo()))
 		return
 	}
 	if rawResponse != nil {
@@ -2069,7 +2109,7 @@ func (cdTektonPipeline *CdTektonPipelineV2) CreateTektonPipelineTriggerWithConte
 	response, err = cdTektonPipeline.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_tekton_pipeline_trigger", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = common.ClassifyError(core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo()))
 		return
 	}
 	if rawResponse != nil {
@@ -2139,7 +2179,7 @@ func (cdTektonPipeline *CdTektonPipelineV2) GetTektonPipelineTriggerWithContext(
 	response, err = cdTektonPipeline.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_tekton_pipeline_trigger", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = common.ClassifyError(core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo()))
 		return
 	}
 	if rawResponse != nil {
@@ -2219,7 +2259,7 @@ func (cdTektonPipeline *CdTektonPipelineV2) UpdateTektonPipelineTriggerWithConte
 	response, err = cdTektonPipeline.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "update_tekton_pipeline_trigger", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = common.ClassifyError(core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo()))
 		return
 	}
 	if rawResponse != nil {
@@ -2287,7 +2327,7 @@ func (cdTektonPipeline *CdTektonPipelineV2) DeleteTektonPipelineTriggerWithConte
 	response, err = cdTektonPipeline.Service.Request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_tekton_pipeline_trigger", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = common.ClassifyError(core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo()))
 		return
 	}
 
@@ -2360,7 +2400,7 @@ func (cdTektonPipeline *CdTektonPipelineV2) DuplicateTektonPipelineTriggerWithCo
 	response, err = cdTektonPipeline.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "duplicate_tekton_pipeline_trigger", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = common.ClassifyError(core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo()))
 		return
 	}
 	if rawResponse != nil {
@@ -2440,7 +2480,7 @@ func (cdTektonPipeline *CdTektonPipelineV2) ListTektonPipelineTriggerPropertiesW
 	response, err = cdTektonPipeline.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_tekton_pipeline_trigger_properties", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = common.ClassifyError(core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo()))
 		return
 	}
 	if rawResponse != nil {
@@ -2536,7 +2576,7 @@ func (cdTektonPipeline *CdTektonPipelineV2) CreateTektonPipelineTriggerPropertie
 	response, err = cdTektonPipeline.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_tekton_pipeline_trigger_properties", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = common.ClassifyError(core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo()))
 		return
 	}
 	if rawResponse != nil {
@@ -2607,7 +2647,7 @@ func (cdTektonPipeline *CdTektonPipelineV2) GetTektonPipelineTriggerPropertyWith
 	response, err = cdTektonPipeline.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_tekton_pipeline_trigger_property", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = common.ClassifyError(core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo()))
 		return
 	}
 	if rawResponse != nil {
@@ -2704,7 +2744,7 @@ func (cdTektonPipeline *CdTektonPipelineV2) ReplaceTektonPipelineTriggerProperty
 	response, err = cdTektonPipeline.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "replace_tekton_pipeline_trigger_property", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = common.ClassifyError(core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo()))
 		return
 	}
 	if rawResponse != nil {
@@ -2773,7 +2813,7 @@ func (cdTektonPipeline *CdTektonPipelineV2) DeleteTektonPipelineTriggerPropertyW
 	response, err = cdTektonPipeline.Service.Request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_tekton_pipeline_trigger_property", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = common.ClassifyError(core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo()))
 		return
 	}
 
@@ -0,0 +1,362 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtektonpipelinev2
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"path"
+	"slices"
+	"sort"
+
+	common "github.com/IBM/continuous-delivery-go-sdk/v2/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Constants associated with the CollectionFilter.Sort property.
+const (
+	CollectionFilterSortNameConst     = "name"
+	CollectionFilterSortNameDescConst = "-name"
+)
+
+// CollectionFilter : A filter and sort order applied on the client to the items of the
+// definitions, properties, triggers and trigger properties pagers. Definitions are matched and
+// sorted on the path of their source, and their type is the type of their source.
+type CollectionFilter struct {
+	// A path.Match pattern that the item name must match.
+	NamePattern string
+
+	// If set, only items of one of these types are kept.
+	Types []string
+
+	// If set, only items with at least one of these tags are kept. Only triggers have tags.
+	Tags []string
+
+	// One of the CollectionFilterSort constants. Items keep the order of the service if empty.
+	Sort string
+}
+
+// validate checks the name pattern and sort order.
+func (filter *CollectionFilter) validate() error {
+	if _, err := path.Match(filter.NamePattern, ""); err != nil {
+		return core.SDKErrorf(err, fmt.Sprintf("invalid name pattern '%s'", filter.NamePattern), "invalid-name-pattern", common.GetComponentInfo())
+	}
+	switch filter.Sort {
+	case "", CollectionFilterSortNameConst, CollectionFilterSortNameDescConst:
+		return nil
+	}
+	return core.SDKErrorf(nil, fmt.Sprintf("invalid sort order '%s'", filter.Sort), "invalid-sort", common.GetComponentInfo())
+}
+
+// matches reports whether an item with the given attributes passes the filter.
+func (filter *CollectionFilter) matches(name string, typeVar string, tags []string) bool {
+	if filter.NamePattern != "" {
+		if matched, _ := path.Match(filter.NamePattern, name); !matched {
+			return false
+		}
+	}
+	if len(filter.Types) > 0 && !slices.Contains(filter.Types, typeVar) {
+		return false
+	}
+	if len(filter.Tags) > 0 && !slices.ContainsFunc(tags, func(tag string) bool { return slices.Contains(filter.Tags, tag) }) {
+		return false
+	}
+	return true
+}
+
+// collectionPager implements the pager methods of the list operations that return their whole
+// collection in a single response, as a pager with one page.
+type collectionPager[T any] struct {
+	hasNext bool
+	filter  *CollectionFilter
+	list    func(ctx context.Context) ([]T, error)
+
+	// attributes returns the name, type and tags of an item.
+	attributes func(item *T) (name string, typeVar string, tags []string)
+}
+
+// HasNext returns true if there are potentially more results to be retrieved.
+func (pager *collectionPager[T]) HasNext() bool {
+	return pager.hasNext
+}
+
+// GetNextWithContext returns the next page of results using the specified Context.
+func (pager *collectionPager[T]) GetNextWithContext(ctx context.Context) (page []T, err error) {
+	if !pager.HasNext() {
+		return nil, fmt.Errorf("no more results available")
+	}
+	if pager.filter != nil {
+		err = pager.filter.validate()
+		if err != nil {
+			return
+		}
+	}
+	items, err := pager.list(ctx)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "error-getting-next-page")
+		return
+	}
+	pager.hasNext = false
+	if pager.filter == nil {
+		return items, nil
+	}
+
+	page = make([]T, 0, len(items))
+	for i := range items {
+		if pager.filter.matches(pager.attributes(&items[i])) {
+			page = append(page, items[i])
+		}
+	}
+	if pager.filter.Sort != "" {
+		descending := pager.filter.Sort == CollectionFilterSortNameDescConst
+		sort.SliceStable(page, func(i, j int) bool {
+			nameI, _, _ := pager.attributes(&page[i])
+			nameJ, _, _ := pager.attributes(&page[j])
+			if descending {
+				return nameI > nameJ
+			}
+			return nameI < nameJ
+		})
+	}
+	return
+}
+
+// GetAllWithContext returns all results by invoking GetNextWithContext() repeatedly
+// until all pages of results have been retrieved.
+func (pager *collectionPager[T]) GetAllWithContext(ctx context.Context) (allItems []T, err error) {
+	for pager.HasNext() {
+		var nextPage []T
+		nextPage, err = pager.GetNextWithContext(ctx)
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "error-getting-next-page")
+			return
+		}
+		allItems = append(allItems, nextPage...)
+	}
+	return
+}
+
+// GetNext invokes GetNextWithContext() using context.Background() as the Context parameter.
+func (pager *collectionPager[T]) GetNext() (page []T, err error) {
+	page, err = pager.GetNextWithContext(context.Background())
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// GetAll invokes GetAllWithContext() using context.Background() as the Context parameter.
+func (pager *collectionPager[T]) GetAll() (allItems []T, err error) {
+	allItems, err = pager.GetAllWithContext(context.Background())
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// Items returns an iterator over the remaining items of the pager. An error ends the iteration.
+func (pager *collectionPager[T]) Items(ctx context.Context) iter.Seq2[T, error] {
+	return common.PageItems(ctx, pager.HasNext, pager.GetNextWithContext)
+}
+
+// TektonPipelineDefinitionsPager can be used to consume the "ListTektonPipelineDefinitions" method
+// like the paginated list methods.
+type TektonPipelineDefinitionsPager struct {
+	collectionPager[Definition]
+}
+
+// NewTektonPipelineDefinitionsPager returns a new TektonPipelineDefinitionsPager instance.
+func (cdTektonPipeline *CdTektonPipelineV2) NewTektonPipelineDefinitionsPager(options *ListTektonPipelineDefinitionsOptions) (pager *TektonPipelineDefinitionsPager, err error) {
+	err = core.ValidateNotNil(options, "options cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	var optionsCopy ListTektonPipelineDefinitionsOptions = *options
+	pager = &TektonPipelineDefinitionsPager{collectionPager[Definition]{
+		hasNext: true,
+		list: func(ctx context.Context) ([]Definition, error) {
+			result, _, err := cdTektonPipeline.ListTektonPipelineDefinitionsWithContext(ctx, &optionsCopy)
+			if err != nil {
+				return nil, err
+			}
+			return result.Definitions, nil
+		},
+		attributes: func(definition *Definition) (string, string, []string) {
+			if definition.Source == nil {
+				return "", "", nil
+			}
+			var name string
+			if definition.Source.Properties != nil {
+				name = core.StringNilMapper(definition.Source.Properties.Path)
+			}
+			return name, core.StringNilMapper(definition.Source.Type), nil
+		},
+	}}
+	return
+}
+
+// SetFilter : Allow user to set a client-side filter and sort order
+func (pager *TektonPipelineDefinitionsPager) SetFilter(filter *CollectionFilter) *TektonPipelineDefinitionsPager {
+	pager.filter = filter
+	return pager
+}
+
+// TektonPipelineDefinitions returns an iterator over the definitions of a pipeline.
+func (cdTektonPipeline *CdTektonPipelineV2) TektonPipelineDefinitions(ctx context.Context, options *ListTektonPipelineDefinitionsOptions) iter.Seq2[Definition, error] {
+	pager, err := cdTektonPipeline.NewTektonPipelineDefinitionsPager(options)
+	if err != nil {
+		return common.ErrorItems[Definition](err)
+	}
+	return pager.Items(ctx)
+}
+
+// TektonPipelinePropertiesPager can be used to consume the "ListTektonPipelineProperties" method
+// like the paginated list methods.
+type TektonPipelinePropertiesPager struct {
+	collectionPager[Property]
+}
+
+// NewTektonPipelinePropertiesPager returns a new TektonPipelinePropertiesPager instance.
+func (cdTektonPipeline *CdTektonPipelineV2) NewTektonPipelinePropertiesPager(options *ListTektonPipelinePropertiesOptions) (pager *TektonPipelinePropertiesPager, err error) {
+	err = core.ValidateNotNil(options, "options cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	var optionsCopy ListTektonPipelinePropertiesOptions = *options
+	pager = &TektonPipelinePropertiesPager{collectionPager[Property]{
+		hasNext: true,
+		list: func(ctx context.Context) ([]Property, error) {
+			result, _, err := cdTektonPipeline.ListTektonPipelinePropertiesWithContext(ctx, &optionsCopy)
+			if err != nil {
+				return nil, err
+			}
+			return result.Properties, nil
+		},
+		attributes: func(property *Property) (string, string, []string) {
+			return core.StringNilMapper(property.Name), core.StringNilMapper(property.Type), nil
+		},
+	}}
+	return
+}
+
+// SetFilter : Allow user to set a client-side filter and sort order
+func (pager *TektonPipelinePropertiesPager) SetFilter(filter *CollectionFilter) *TektonPipelinePropertiesPager {
+	pager.filter = filter
+	return pager
+}
+
+// TektonPipelineProperties returns an iterator over the properties of a pipeline.
+func (cdTektonPipeline *CdTektonPipelineV2) TektonPipelineProperties(ctx context.Context, options *ListTektonPipelinePropertiesOptions) iter.Seq2[Property, error] {
+	pager, err := cdTektonPipeline.NewTektonPipelinePropertiesPager(options)
+	if err != nil {
+		return common.ErrorItems[Property](err)
+	}
+	return pager.Items(ctx)
+}
+
+// TektonPipelineTriggersPager can be used to consume the "ListTektonPipelineTriggers" method like
+// the paginated list methods.
+type TektonPipelineTriggersPager struct {
+	collectionPager[TriggerIntf]
+}
+
+// NewTektonPipelineTriggersPager returns a new TektonPipelineTriggersPager instance.
+func (cdTektonPipeline *CdTektonPipelineV2) NewTektonPipelineTriggersPager(options *ListTektonPipelineTriggersOptions) (pager *TektonPipelineTriggersPager, err error) {
+	err = core.ValidateNotNil(options, "options cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	var optionsCopy ListTektonPipelineTriggersOptions = *options
+	pager = &TektonPipelineTriggersPager{collectionPager[TriggerIntf]{
+		hasNext: true,
+		list: func(ctx context.Context) ([]TriggerIntf, error) {
+			result, _, err := cdTektonPipeline.ListTektonPipelineTriggersWithContext(ctx, &optionsCopy)
+			if err != nil {
+				return nil, err
+			}
+			return result.Triggers, nil
+		},
+		attributes: func(trigger *TriggerIntf) (string, string, []string) {
+			t := AsTrigger(*trigger)
+			if t == nil {
+				return "", "", nil
+			}
+			return core.StringNilMapper(t.Name), core.StringNilMapper(t.Type), t.Tags
+		},
+	}}
+	return
+}
+
+// SetFilter : Allow user to set a client-side filter and sort order
+func (pager *TektonPipelineTriggersPager) SetFilter(filter *CollectionFilter) *TektonPipelineTriggersPager {
+	pager.filter = filter
+	return pager
+}
+
+// TektonPipelineTriggers returns an iterator over the triggers of a pipeline.
+func (cdTektonPipeline *CdTektonPipelineV2) TektonPipelineTriggers(ctx context.Context, options *ListTektonPipelineTriggersOptions) iter.Seq2[TriggerIntf, error] {
+	pager, err := cdTektonPipeline.NewTektonPipelineTriggersPager(options)
+	if err != nil {
+		return common.ErrorItems[TriggerIntf](err)
+	}
+	return pager.Items(ctx)
+}
+
+// TektonPipelineTriggerPropertiesPager can be used to consume the
+// "ListTektonPipelineTriggerProperties" method like the paginated list methods.
+type TektonPipelineTriggerPropertiesPager struct {
+	collectionPager[TriggerProperty]
+}
+
+// NewTektonPipelineTriggerPropertiesPager returns a new TektonPipelineTriggerPropertiesPager instance.
+func (cdTektonPipeline *CdTektonPipelineV2) NewTektonPipelineTriggerPropertiesPager(options *ListTektonPipelineTriggerPropertiesOptions) (pager *TektonPipelineTriggerPropertiesPager, err error) {
+	err = core.ValidateNotNil(options, "options cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	var optionsCopy ListTektonPipelineTriggerPropertiesOptions = *options
+	pager = &TektonPipelineTriggerPropertiesPager{collectionPager[TriggerProperty]{
+		hasNext: true,
+		list: func(ctx context.Context) ([]TriggerProperty, error) {
+			result, _, err := cdTektonPipeline.ListTektonPipelineTriggerPropertiesWithContext(ctx, &optionsCopy)
+			if err != nil {
+				return nil, err
+			}
+			return result.Properties, nil
+		},
+		attributes: func(property *TriggerProperty) (string, string, []string) {
+			return core.StringNilMapper(property.Name), core.StringNilMapper(property.Type), nil
+		},
+	}}
+	return
+}
+
+// SetFilter : Allow user to set a client-side filter and sort order
+func (pager *TektonPipelineTriggerPropertiesPager) SetFilter(filter *CollectionFilter) *TektonPipelineTriggerPropertiesPager {
+	pager.filter = filter
+	return pager
+}
+
+// TektonPipelineTriggerProperties returns an iterator over the properties of a trigger.
+func (cdTektonPipeline *CdTektonPipelineV2) TektonPipelineTriggerProperties(ctx context.Context, options *ListTektonPipelineTriggerPropertiesOptions) iter.Seq2[TriggerProperty, error] {
+	pager, err := cdTektonPipeline.NewTektonPipelineTriggerPropertiesPager(options)
+	if err != nil {
+		return common.ErrorItems[TriggerProperty](err)
+	}
+	return pager.Items(ctx)
+}
@@ -0,0 +1,145 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtektonpipelinev2_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtektonpipelinev2"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Collection pagers`, func() {
+	var (
+		testServer              *httptest.Server
+		cdTektonPipelineService *cdtektonpipelinev2.CdTektonPipelineV2
+		requests                int
+	)
+
+	BeforeEach(func() {
+		requests = 0
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			requests++
+			res.Header().Set("Content-type", "application/json")
+			switch req.URL.Path {
+			case "/tekton_pipelines/p1/definitions":
+				fmt.Fprint(res, `{"definitions": [
+					{"id": "d1", "source": {"type": "git", "properties": {"url": "https://repo", "path": "tekton/ci"}}},
+					{"id": "d2", "source": {"type": "git", "properties": {"url": "https://repo", "path": "tekton/cd"}}},
+					{"id": "d3", "source": {"type": "git", "properties": {"url": "https://repo", "path": ".tekton"}}}]}`)
+			case "/tekton_pipelines/p1/properties":
+				fmt.Fprint(res, `{"properties": [
+					{"name": "region", "type": "text", "value": "us-south"},
+					{"name": "api-key", "type": "secure", "value": "hash"},
+					{"name": "app-name", "type": "text", "value": "shop"}]}`)
+			case "/tekton_pipelines/p1/triggers":
+				fmt.Fprint(res, `{"triggers": [
+					{"id": "t1", "type": "manual", "name": "deploy-prod", "tags": ["prod"]},
+					{"id": "t2", "type": "scm", "name": "build", "tags": ["ci"]},
+					{"id": "t3", "type": "manual", "name": "deploy-dev", "tags": ["dev", "ci"]},
+					{"id": "t4", "type": "timer", "name": "nightly"}]}`)
+			case "/tekton_pipelines/p1/triggers/t1/properties":
+				fmt.Fprint(res, `{"properties": [{"name": "b", "type": "text"}, {"name": "a", "type": "text"}]}`)
+			default:
+				res.WriteHeader(404)
+				fmt.Fprint(res, `{"errors": [{"message": "not found"}]}`)
+			}
+		}))
+		var err error
+		cdTektonPipelineService, err = cdtektonpipelinev2.NewCdTektonPipelineV2(&cdtektonpipelinev2.CdTektonPipelineV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Page through definitions`, func() {
+		pager, err := cdTektonPipelineService.NewTektonPipelineDefinitionsPager(cdTektonPipelineService.NewListTektonPipelineDefinitionsOptions("p1"))
+		Expect(err).To(BeNil())
+		pager.SetFilter(&cdtektonpipelinev2.CollectionFilter{NamePattern: "tekton/*", Sort: "name"})
+		Expect(pager.HasNext()).To(BeTrue())
+		page, err := pager.GetNext()
+		Expect(err).To(BeNil())
+		Expect(pager.HasNext()).To(BeFalse())
+		Expect(page).To(HaveLen(2))
+		Expect(*page[0].ID).To(Equal("d2"))
+		Expect(*page[1].ID).To(Equal("d1"))
+		_, err = pager.GetNext()
+		Expect(err).ToNot(BeNil())
+
+		var ids []string
+		for definition, err := range cdTektonPipelineService.TektonPipelineDefinitions(context.Background(), cdTektonPipelineService.NewListTektonPipelineDefinitionsOptions("p1")) {
+			Expect(err).To(BeNil())
+			ids = append(ids, *definition.ID)
+		}
+		Expect(ids).To(Equal([]string{"d1", "d2", "d3"}))
+	})
+	It(`Filter and sort properties`, func() {
+		pager, err := cdTektonPipelineService.NewTektonPipelinePropertiesPager(cdTektonPipelineService.NewListTektonPipelinePropertiesOptions("p1"))
+		Expect(err).To(BeNil())
+		properties, err := pager.SetFilter(&cdtektonpipelinev2.CollectionFilter{Types: []string{"text"}, Sort: "-name"}).GetAll()
+		Expect(err).To(BeNil())
+		Expect(properties).To(HaveLen(2))
+		Expect(*properties[0].Name).To(Equal("region"))
+		Expect(*properties[1].Name).To(Equal("app-name"))
+
+		pager, err = cdTektonPipelineService.NewTektonPipelinePropertiesPager(cdTektonPipelineService.NewListTektonPipelinePropertiesOptions("p1"))
+		Expect(err).To(BeNil())
+		_, err = pager.SetFilter(&cdtektonpipelinev2.CollectionFilter{Sort: "type"}).GetAll()
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("invalid sort order 'type'"))
+		_, err = pager.SetFilter(&cdtektonpipelinev2.CollectionFilter{NamePattern: "["}).GetAll()
+		Expect(err).ToNot(BeNil())
+		Expect(requests).To(Equal(1))
+	})
+	It(`Filter triggers by tag and type`, func() {
+		pager, err := cdTektonPipelineService.NewTektonPipelineTriggersPager(cdTektonPipelineService.NewListTektonPipelineTriggersOptions("p1"))
+		Expect(err).To(BeNil())
+		var names []string
+		for trigger, err := range pager.SetFilter(&cdtektonpipelinev2.CollectionFilter{Tags: []string{"ci", "prod"}, Types: []string{"manual"}, Sort: "name"}).Items(context.Background()) {
+			Expect(err).To(BeNil())
+			names = append(names, *cdtektonpipelinev2.AsTrigger(trigger).Name)
+		}
+		Expect(names).To(Equal([]string{"deploy-dev", "deploy-prod"}))
+	})
+	It(`Iterate over trigger properties and report errors`, func() {
+		var names []string
+		for property, err := range cdTektonPipelineService.TektonPipelineTriggerProperties(context.Background(), cdTektonPipelineService.NewListTektonPipelineTriggerPropertiesOptions("p1", "t1")) {
+			Expect(err).To(BeNil())
+			names = append(names, *property.Name)
+		}
+		Expect(names).To(Equal([]string{"b", "a"}))
+
+		var errs []error
+		for _, err := range cdTektonPipelineService.TektonPipelineTriggerProperties(context.Background(), cdTektonPipelineService.NewListTektonPipelineTriggerPropertiesOptions("p1", "t9")) {
+			errs = append(errs, err)
+		}
+		Expect(errs).To(HaveLen(1))
+		Expect(errs[0].Error()).To(ContainSubstring("not found"))
+		for _, err := range cdTektonPipelineService.TektonPipelineTriggers(context.Background(), nil) {
+			Expect(err).ToNot(BeNil())
+		}
+	})
+})
@@ -0,0 +1,210 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtektonpipelinev2
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtoolchainv2"
+	common "github.com/IBM/continuous-delivery-go-sdk/v2/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Constants associated with the EffectiveProperty.Source property.
+const (
+	EffectivePropertySourcePipelineConst = "pipeline"
+	EffectivePropertySourceTriggerConst  = "trigger"
+)
+
+// EffectiveProperty : A property as a pipeline run would receive it.
+type EffectiveProperty struct {
+	// Property name.
+	Name string `json:"name"`
+
+	// Property type.
+	Type string `json:"type"`
+
+	// The effective value. For `integration` properties this is the tool data selected by Path,
+	// which may be a string, a list or an object. Secure values are masked.
+	Value interface{} `json:"value"`
+
+	// Whether the property comes from the pipeline or the trigger.
+	Source string `json:"source"`
+
+	// The referenced tool, for `integration` properties.
+	ToolID string `json:"tool_id,omitempty"`
+
+	// The path into the referenced tool, for `integration` properties.
+	Path string `json:"path,omitempty"`
+
+	// A description of why an `integration` property could not be expanded.
+	Error string `json:"error,omitempty"`
+}
+
+// ResolveEffectiveConfigurationOptions : The ResolveEffectiveConfiguration options.
+type ResolveEffectiveConfigurationOptions struct {
+	// The pipeline, as returned by GetTektonPipeline.
+	Pipeline *TektonPipeline
+
+	// Client for the toolchain service of the pipeline's parent toolchain.
+	ToolchainClient *cdtoolchainv2.CdToolchainV2
+
+	// Optional trigger ID. When set, the trigger's properties are applied over the pipeline's,
+	// except where the pipeline property is locked.
+	TriggerID string
+}
+
+// ResolveEffectiveConfiguration invokes ResolveEffectiveConfigurationWithContext using context.Background().
+func ResolveEffectiveConfiguration(options *ResolveEffectiveConfigurationOptions) (properties []EffectiveProperty, err error) {
+	properties, err = ResolveEffectiveConfigurationWithContext(context.Background(), options)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// ResolveEffectiveConfigurationWithContext returns the pipeline's properties, sorted by name, with
+// `integration` values expanded to the referenced tool data of the parent toolchain and secure
+// values masked. Tool parameters that hold credentials are masked in expanded values as well.
+func ResolveEffectiveConfigurationWithContext(ctx context.Context, options *ResolveEffectiveConfigurationOptions) (properties []EffectiveProperty, err error) {
+	err = core.ValidateNotNil(options, "options cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	if options.Pipeline == nil || options.ToolchainClient == nil {
+		err = core.SDKErrorf(nil, "the 'options.Pipeline' and 'options.ToolchainClient' fields must be set", "missing-required-option", common.GetComponentInfo())
+		return
+	}
+	pipeline := options.Pipeline
+	if pipeline.Toolchain == nil || pipeline.Toolchain.ID == nil {
+		err = core.SDKErrorf(nil, "the pipeline has no parent toolchain", "missing-toolchain", common.GetComponentInfo())
+		return
+	}
+
+	merged, err := mergeRunProperties(pipeline, options.TriggerID)
+	if err != nil {
+		return
+	}
+
+	tools := map[string]*cdtoolchainv2.ToolchainTool{}
+	pager, err := options.ToolchainClient.NewToolsPager(options.ToolchainClient.NewListToolsOptions(*pipeline.Toolchain.ID))
+	if err != nil {
+		return
+	}
+	for pager.HasNext() {
+		var page []cdtoolchainv2.ToolModel
+		page, err = pager.GetNextWithContext(ctx)
+		if err != nil {
+			return
+		}
+		for i := range page {
+			tools[core.StringNilMapper(page[i].ID)] = toolFromModel(&page[i])
+		}
+	}
+
+	for _, entry := range merged {
+		effective := EffectiveProperty{
+			Name:   core.StringNilMapper(entry.property.Name),
+			Type:   core.StringNilMapper(entry.property.Type),
+			Value:  core.StringNilMapper(entry.property.Value),
+			Source: entry.source,
+		}
+		switch effective.Type {
+		case PropertyTypeSecureConst:
+			effective.Value = displayValue(entry.property.Type, entry.property.Value)
+		case PropertyTypeIntegrationConst:
+			effective.ToolID = core.StringNilMapper(entry.property.Value)
+			effective.Path = core.StringNilMapper(entry.property.Path)
+			tool := tools[effective.ToolID]
+			if tool == nil {
+				effective.Error = fmt.Sprintf("tool '%s' does not exist in toolchain '%s'", effective.ToolID, *pipeline.Toolchain.ID)
+				break
+			}
+			value, found := ResolveToolPath(tool, effective.Path)
+			if !found {
+				effective.Error = fmt.Sprintf("path '%s' does not resolve in tool '%s'", effective.Path, effective.ToolID)
+				break
+			}
+			effective.Value = common.RedactValue(lastPathSegment(effective.Path), value)
+		}
+		properties = append(properties, effective)
+	}
+	return
+}
+
+type runProperty struct {
+	property Property
+	source   string
+}
+
+// mergeRunProperties returns the pipeline properties overridden by those of the trigger, sorted by name.
+func mergeRunProperties(pipeline *TektonPipeline, triggerID string) (merged []runProperty, err error) {
+	byName := map[string]runProperty{}
+	for _, property := range pipeline.Properties {
+		byName[core.StringNilMapper(property.Name)] = runProperty{property: property, source: EffectivePropertySourcePipelineConst}
+	}
+	if triggerID != "" {
+		var trigger *Trigger
+		for _, item := range pipeline.Triggers {
+			if t := AsTrigger(item); t != nil && core.StringNilMapper(t.ID) == triggerID {
+				trigger = t
+				break
+			}
+		}
+		if trigger == nil {
+			err = core.SDKErrorf(nil, fmt.Sprintf("trigger '%s' not found in pipeline", triggerID), "trigger-not-found", common.GetComponentInfo())
+			return
+		}
+		for i := range trigger.Properties {
+			name := core.StringNilMapper(trigger.Properties[i].Name)
+			if current, exists := byName[name]; exists && current.property.Locked != nil && *current.property.Locked {
+				continue
+			}
+			byName[name] = runProperty{property: trigger.Properties[i].AsProperty(), source: EffectivePropertySourceTriggerConst}
+		}
+	}
+	for _, entry := range byName {
+		merged = append(merged, entry)
+	}
+	sort.Slice(merged, func(i, j int) bool {
+		return core.StringNilMapper(merged[i].property.Name) < core.StringNilMapper(merged[j].property.Name)
+	})
+	return
+}
+
+func toolFromModel(model *cdtoolchainv2.ToolModel) *cdtoolchainv2.ToolchainTool {
+	return &cdtoolchainv2.ToolchainTool{
+		ID:              model.ID,
+		ResourceGroupID: model.ResourceGroupID,
+		CRN:             model.CRN,
+		ToolTypeID:      model.ToolTypeID,
+		ToolchainID:     model.ToolchainID,
+		ToolchainCRN:    model.ToolchainCRN,
+		Href:            model.Href,
+		Referent:        model.Referent,
+		Name:            model.Name,
+		UpdatedAt:       model.UpdatedAt,
+		Parameters:      model.Parameters,
+		State:           model.State,
+	}
+}
+
+func lastPathSegment(path string) string {
+	return path[strings.LastIndex(path, ".")+1:]
+}
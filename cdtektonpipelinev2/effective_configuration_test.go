@@ -0,0 +1,118 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtektonpipelinev2_test
+
+import (
+	"net/http/httptest"
+
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtektonpipelinev2"
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtoolchainv2"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`ResolveEffectiveConfiguration`, func() {
+	var (
+		toolchainServer    *httptest.Server
+		cdToolchainService *cdtoolchainv2.CdToolchainV2
+	)
+	pipeline := &cdtektonpipelinev2.TektonPipeline{
+		ID:        core.StringPtr("p1"),
+		Toolchain: &cdtektonpipelinev2.ToolchainReference{ID: core.StringPtr("tc1")},
+		Properties: []cdtektonpipelinev2.Property{
+			{Name: core.StringPtr("stage"), Type: core.StringPtr("text"), Value: core.StringPtr("dev")},
+			{Name: core.StringPtr("region"), Type: core.StringPtr("text"), Value: core.StringPtr("us-south"), Locked: core.BoolPtr(true)},
+			{Name: core.StringPtr("password"), Type: core.StringPtr("secure"), Value: core.StringPtr("hunter2")},
+			{Name: core.StringPtr("repo"), Type: core.StringPtr("integration"), Value: core.StringPtr("repo")},
+			{Name: core.StringPtr("repo-url"), Type: core.StringPtr("integration"), Value: core.StringPtr("repo"), Path: core.StringPtr("parameters.repo_url")},
+			{Name: core.StringPtr("repo-key"), Type: core.StringPtr("integration"), Value: core.StringPtr("repo"), Path: core.StringPtr("api_key")},
+			{Name: core.StringPtr("chat"), Type: core.StringPtr("integration"), Value: core.StringPtr("gone")},
+		},
+		Triggers: []cdtektonpipelinev2.TriggerIntf{
+			&cdtektonpipelinev2.Trigger{ID: core.StringPtr("t1"), Properties: []cdtektonpipelinev2.TriggerProperty{
+				{Name: core.StringPtr("stage"), Type: core.StringPtr("text"), Value: core.StringPtr("prod")},
+				{Name: core.StringPtr("region"), Type: core.StringPtr("text"), Value: core.StringPtr("eu-de")},
+			}},
+		},
+	}
+
+	BeforeEach(func() {
+		toolchainServer = newMockToolchainServer()
+		var err error
+		cdToolchainService, err = cdtoolchainv2.NewCdToolchainV2(&cdtoolchainv2.CdToolchainV2Options{
+			URL:           toolchainServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+	})
+	AfterEach(func() {
+		toolchainServer.Close()
+	})
+
+	It(`Invoke ResolveEffectiveConfiguration successfully`, func() {
+		properties, err := cdtektonpipelinev2.ResolveEffectiveConfiguration(&cdtektonpipelinev2.ResolveEffectiveConfigurationOptions{
+			Pipeline:        pipeline,
+			ToolchainClient: cdToolchainService,
+		})
+		Expect(err).To(BeNil())
+		byName := map[string]cdtektonpipelinev2.EffectiveProperty{}
+		names := []string{}
+		for _, property := range properties {
+			byName[property.Name] = property
+			names = append(names, property.Name)
+		}
+		Expect(names).To(Equal([]string{"chat", "password", "region", "repo", "repo-key", "repo-url", "stage"}))
+		Expect(byName["stage"].Value).To(Equal("dev"))
+		Expect(byName["password"].Value).To(Equal("********"))
+		Expect(byName["repo-url"].Value).To(Equal("https://github.com/org/app"))
+		Expect(byName["repo-key"].Value).To(Equal("********"))
+		Expect(byName["chat"].Error).To(ContainSubstring("does not exist"))
+
+		repo := byName["repo"].Value.(map[string]interface{})
+		Expect(repo["tool_type_id"]).To(Equal("githubconsolidated"))
+		parameters := repo["parameters"].(map[string]interface{})
+		Expect(parameters["api_key"]).To(Equal("********"))
+		Expect(parameters["token_url"]).To(Equal("https://token"))
+		Expect(parameters["owners"]).To(Equal([]interface{}{"alice", "bob"}))
+	})
+	It(`Invoke ResolveEffectiveConfiguration for a trigger`, func() {
+		properties, err := cdtektonpipelinev2.ResolveEffectiveConfiguration(&cdtektonpipelinev2.ResolveEffectiveConfigurationOptions{
+			Pipeline:        pipeline,
+			ToolchainClient: cdToolchainService,
+			TriggerID:       "t1",
+		})
+		Expect(err).To(BeNil())
+		for _, property := range properties {
+			switch property.Name {
+			case "stage":
+				Expect(property.Value).To(Equal("prod"))
+				Expect(property.Source).To(Equal(cdtektonpipelinev2.EffectivePropertySourceTriggerConst))
+			case "region":
+				Expect(property.Value).To(Equal("us-south"))
+				Expect(property.Source).To(Equal(cdtektonpipelinev2.EffectivePropertySourcePipelineConst))
+			}
+		}
+
+		_, err = cdtektonpipelinev2.ResolveEffectiveConfiguration(&cdtektonpipelinev2.ResolveEffectiveConfigurationOptions{
+			Pipeline:        pipeline,
+			ToolchainClient: cdToolchainService,
+			TriggerID:       "t9",
+		})
+		Expect(err).ToNot(BeNil())
+	})
+})
@@ -0,0 +1,414 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtektonpipelinev2
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	common "github.com/IBM/continuous-delivery-go-sdk/v2/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// FreezeWindowTimeLayout is the layout used for the Start and End fields of a FreezeWindow.
+// Times are interpreted in the window's Timezone.
+const FreezeWindowTimeLayout = "2006-01-02T15:04"
+
+// FreezeWindow : A period during which matching triggers are disabled.
+type FreezeWindow struct {
+	// Unique name of the freeze window.
+	Name string `json:"name"`
+
+	// Start of the window, formatted according to FreezeWindowTimeLayout.
+	Start string `json:"start"`
+
+	// End of the window, formatted according to FreezeWindowTimeLayout.
+	End string `json:"end"`
+
+	// IANA timezone in which Start and End are expressed. If empty, UTC is used.
+	Timezone string `json:"timezone,omitempty"`
+
+	// Triggers having at least one of these tags are frozen.
+	Tags []string `json:"tags,omitempty"`
+
+	// Triggers of one of these types ("manual", "scm", "timer", "generic") are frozen.
+	Types []string `json:"types,omitempty"`
+}
+
+// Bounds returns the start and end instants of the window.
+func (window *FreezeWindow) Bounds() (start time.Time, end time.Time, err error) {
+	location := time.UTC
+	if window.Timezone != "" {
+		location, err = time.LoadLocation(window.Timezone)
+		if err != nil {
+			err = core.SDKErrorf(err, fmt.Sprintf("invalid timezone '%s' for freeze window '%s'", window.Timezone, window.Name), "freeze-window-timezone", common.GetComponentInfo())
+			return
+		}
+	}
+	start, err = time.ParseInLocation(FreezeWindowTimeLayout, window.Start, location)
+	if err != nil {
+		err = core.SDKErrorf(err, fmt.Sprintf("invalid start for freeze window '%s'", window.Name), "freeze-window-start", common.GetComponentInfo())
+		return
+	}
+	end, err = time.ParseInLocation(FreezeWindowTimeLayout, window.End, location)
+	if err != nil {
+		err = core.SDKErrorf(err, fmt.Sprintf("invalid end for freeze window '%s'", window.Name), "freeze-window-end", common.GetComponentInfo())
+		return
+	}
+	if !end.After(start) {
+		err = core.SDKErrorf(nil, fmt.Sprintf("freeze window '%s' must end after it starts", window.Name), "freeze-window-range", common.GetComponentInfo())
+	}
+	return
+}
+
+// Matches returns true if the trigger is selected by the window's tags or types.
+func (window *FreezeWindow) Matches(trigger *Trigger) bool {
+	if trigger == nil {
+		return false
+	}
+	if trigger.Type != nil {
+		for _, triggerType := range window.Types {
+			if triggerType == *trigger.Type {
+				return true
+			}
+		}
+	}
+	return hasAnyTag(window.Tags, trigger.Tags)
+}
+
+// LoadFreezeCalendar reads a JSON array of freeze windows from the specified file.
+func LoadFreezeCalendar(path string) (windows []FreezeWindow, err error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "freeze-calendar-read", common.GetComponentInfo())
+		return
+	}
+	err = json.Unmarshal(data, &windows)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "freeze-calendar-parse", common.GetComponentInfo())
+		return
+	}
+	for i := range windows {
+		if _, _, err = windows[i].Bounds(); err != nil {
+			return nil, err
+		}
+	}
+	return
+}
+
+// FrozenTrigger : A trigger disabled by a freeze window.
+type FrozenTrigger struct {
+	PipelineID string   `json:"pipeline_id"`
+	TriggerID  string   `json:"trigger_id"`
+	Name       string   `json:"name,omitempty"`
+	Type       string   `json:"type,omitempty"`
+	Tags       []string `json:"tags,omitempty"`
+}
+
+func (frozen *FrozenTrigger) asTrigger() *Trigger {
+	return &Trigger{
+		ID:   core.StringPtr(frozen.TriggerID),
+		Name: core.StringPtr(frozen.Name),
+		Type: core.StringPtr(frozen.Type),
+		Tags: frozen.Tags,
+	}
+}
+
+// FreezeState : The persisted state of a FreezeScheduler, keyed by freeze window name.
+type FreezeState struct {
+	Windows map[string][]FrozenTrigger `json:"windows"`
+}
+
+// FreezeSchedulerOptions : The FreezeScheduler options.
+type FreezeSchedulerOptions struct {
+	// The IDs of the Tekton pipelines whose triggers are managed.
+	PipelineIDs []string
+
+	// The freeze calendar.
+	Windows []FreezeWindow
+
+	// Path of the file used to persist which triggers were disabled by the scheduler.
+	StateFile string
+}
+
+// FreezeScheduler disables matching triggers while a freeze window is active and
+// restores them once the window ends. Only triggers that the scheduler itself disabled
+// are re-enabled, and that record survives restarts through the state file.
+type FreezeScheduler struct {
+	client      *CdTektonPipelineV2
+	pipelineIDs []string
+	windows     []FreezeWindow
+	stateFile   string
+
+	mutex sync.Mutex
+	state *FreezeState
+}
+
+// NewFreezeScheduler returns a new FreezeScheduler, loading any state previously persisted to options.StateFile.
+func (cdTektonPipeline *CdTektonPipelineV2) NewFreezeScheduler(options *FreezeSchedulerOptions) (scheduler *FreezeScheduler, err error) {
+	err = core.ValidateNotNil(options, "options cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	if options.StateFile == "" {
+		err = core.SDKErrorf(nil, "the 'options.StateFile' field must be set", "missing-state-file", common.GetComponentInfo())
+		return
+	}
+	names := map[string]bool{}
+	for i := range options.Windows {
+		if _, _, err = options.Windows[i].Bounds(); err != nil {
+			return
+		}
+		if names[options.Windows[i].Name] {
+			err = core.SDKErrorf(nil, fmt.Sprintf("duplicate freeze window name '%s'", options.Windows[i].Name), "duplicate-freeze-window", common.GetComponentInfo())
+			return
+		}
+		names[options.Windows[i].Name] = true
+	}
+
+	scheduler = &FreezeScheduler{
+		client:      cdTektonPipeline,
+		pipelineIDs: append([]string(nil), options.PipelineIDs...),
+		windows:     append([]FreezeWindow(nil), options.Windows...),
+		stateFile:   options.StateFile,
+	}
+	scheduler.state, err = scheduler.loadState()
+	if err != nil {
+		scheduler = nil
+	}
+	return
+}
+
+// State returns a copy of the scheduler's current state.
+func (scheduler *FreezeScheduler) State() *FreezeState {
+	scheduler.mutex.Lock()
+	defer scheduler.mutex.Unlock()
+
+	state := &FreezeState{Windows: map[string][]FrozenTrigger{}}
+	for name, frozen := range scheduler.state.Windows {
+		state.Windows[name] = append([]FrozenTrigger(nil), frozen...)
+	}
+	return state
+}
+
+// Reconcile applies the freeze calendar as of the specified instant: triggers matched by
+// a window that has started are disabled, and triggers frozen by a window that has ended
+// are re-enabled unless another active window still matches them.
+func (scheduler *FreezeScheduler) Reconcile(ctx context.Context, now time.Time) (err error) {
+	scheduler.mutex.Lock()
+	defer scheduler.mutex.Unlock()
+
+	active := []*FreezeWindow{}
+	for i := range scheduler.windows {
+		start, end, _ := scheduler.windows[i].Bounds()
+		if !now.Before(start) && now.Before(end) {
+			active = append(active, &scheduler.windows[i])
+		}
+	}
+
+	var errs []error
+	if e := scheduler.thaw(ctx, active); e != nil {
+		errs = append(errs, e)
+	}
+	for _, window := range active {
+		if e := scheduler.freeze(ctx, window); e != nil {
+			errs = append(errs, e)
+		}
+	}
+	if len(errs) > 0 {
+		err = core.SDKErrorf(errors.Join(errs...), "", "freeze-reconcile-error", common.GetComponentInfo())
+	}
+	return
+}
+
+// Run calls Reconcile every interval until the context is cancelled. Reconciliation
+// errors are passed to onError, if specified, and do not stop the scheduler.
+func (scheduler *FreezeScheduler) Run(ctx context.Context, interval time.Duration, onError func(error)) error {
+	if interval <= 0 {
+		return core.SDKErrorf(nil, "the interval must be positive", "invalid-interval", common.GetComponentInfo())
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if err := scheduler.Reconcile(ctx, time.Now()); err != nil && onError != nil {
+			onError(err)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// thaw re-enables the triggers of every recorded window that is no longer active.
+func (scheduler *FreezeScheduler) thaw(ctx context.Context, active []*FreezeWindow) (err error) {
+	isActive := map[string]bool{}
+	for _, window := range active {
+		isActive[window.Name] = true
+	}
+
+	var errs []error
+	for name, frozen := range scheduler.state.Windows {
+		if isActive[name] {
+			continue
+		}
+		remaining := []FrozenTrigger{}
+		for _, trigger := range frozen {
+			// Hand the trigger over to another active window that still covers it.
+			if owner := matchingWindow(active, trigger.asTrigger()); owner != nil {
+				scheduler.record(owner.Name, trigger)
+				continue
+			}
+			if e := scheduler.setEnabled(ctx, trigger.PipelineID, trigger.TriggerID, true); e != nil && !errors.Is(e, common.ErrNotFound) {
+				errs = append(errs, e)
+				remaining = append(remaining, trigger)
+			}
+		}
+		if len(remaining) > 0 {
+			scheduler.state.Windows[name] = remaining
+		} else {
+			delete(scheduler.state.Windows, name)
+		}
+		if e := scheduler.saveState(); e != nil {
+			return e
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// freeze disables the enabled triggers matched by the window and records them.
+func (scheduler *FreezeScheduler) freeze(ctx context.Context, window *FreezeWindow) (err error) {
+	var errs []error
+	for _, pipelineID := range scheduler.pipelineIDs {
+		listOptions := scheduler.client.NewListTektonPipelineTriggersOptions(pipelineID)
+		listOptions.SetDisabled("false")
+		result, _, e := scheduler.client.ListTektonPipelineTriggersWithContext(ctx, listOptions)
+		if e != nil {
+			errs = append(errs, e)
+			continue
+		}
+		for _, item := range result.Triggers {
+			trigger := AsTrigger(item)
+			if trigger == nil || trigger.ID == nil || !window.Matches(trigger) {
+				continue
+			}
+			if trigger.Enabled != nil && !*trigger.Enabled {
+				continue
+			}
+			if e = scheduler.setEnabled(ctx, pipelineID, *trigger.ID, false); e != nil {
+				errs = append(errs, e)
+				continue
+			}
+			scheduler.record(window.Name, FrozenTrigger{
+				PipelineID: pipelineID,
+				TriggerID:  *trigger.ID,
+				Name:       core.StringNilMapper(trigger.Name),
+				Type:       core.StringNilMapper(trigger.Type),
+				Tags:       trigger.Tags,
+			})
+			if e = scheduler.saveState(); e != nil {
+				return e
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// record adds a trigger to the triggers frozen by a window, unless it is already recorded there,
+// as when a frozen trigger was re-enabled manually and disabled again.
+func (scheduler *FreezeScheduler) record(windowName string, trigger FrozenTrigger) {
+	for _, frozen := range scheduler.state.Windows[windowName] {
+		if frozen.PipelineID == trigger.PipelineID && frozen.TriggerID == trigger.TriggerID {
+			return
+		}
+	}
+	scheduler.state.Windows[windowName] = append(scheduler.state.Windows[windowName], trigger)
+}
+
+func (scheduler *FreezeScheduler) setEnabled(ctx context.Context, pipelineID string, triggerID string, enabled bool) error {
+	patch, err := (&TriggerPatch{Enabled: core.BoolPtr(enabled)}).AsPatch()
+	if err != nil {
+		return err
+	}
+	updateOptions := scheduler.client.NewUpdateTektonPipelineTriggerOptions(pipelineID, triggerID)
+	updateOptions.SetTriggerPatch(patch)
+	_, _, err = scheduler.client.UpdateTektonPipelineTriggerWithContext(ctx, updateOptions)
+	return err
+}
+
+func (scheduler *FreezeScheduler) loadState() (state *FreezeState, err error) {
+	state = &FreezeState{Windows: map[string][]FrozenTrigger{}}
+	data, err := os.ReadFile(scheduler.stateFile)
+	if errors.Is(err, os.ErrNotExist) {
+		return state, nil
+	}
+	if err != nil {
+		err = core.SDKErrorf(err, "", "freeze-state-read", common.GetComponentInfo())
+		return
+	}
+	err = json.Unmarshal(data, state)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "freeze-state-parse", common.GetComponentInfo())
+		return
+	}
+	if state.Windows == nil {
+		state.Windows = map[string][]FrozenTrigger{}
+	}
+	return
+}
+
+// saveState writes the state to a temporary file and renames it over the state file so
+// that an interrupted write never leaves a truncated state behind.
+func (scheduler *FreezeScheduler) saveState() (err error) {
+	data, err := json.MarshalIndent(scheduler.state, "", "  ")
+	if err != nil {
+		return core.SDKErrorf(err, "", "freeze-state-marshal", common.GetComponentInfo())
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(scheduler.stateFile), filepath.Base(scheduler.stateFile)+".*")
+	if err != nil {
+		return core.SDKErrorf(err, "", "freeze-state-write", common.GetComponentInfo())
+	}
+	_, err = tmp.Write(data)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), scheduler.stateFile)
+	}
+	if err != nil {
+		os.Remove(tmp.Name())
+		return core.SDKErrorf(err, "", "freeze-state-write", common.GetComponentInfo())
+	}
+	return nil
+}
+
+func matchingWindow(windows []*FreezeWindow, trigger *Trigger) *FreezeWindow {
+	for _, window := range windows {
+		if window.Matches(trigger) {
+			return window
+		}
+	}
+	return nil
+}
@@ -0,0 +1,220 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtektonpipelinev2_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtektonpipelinev2"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`FreezeScheduler`, func() {
+	var (
+		testServer              *httptest.Server
+		cdTektonPipelineService *cdtektonpipelinev2.CdTektonPipelineV2
+		tempDir                 string
+		stateFile               string
+		mutex                   sync.Mutex
+		triggers                map[string]map[string]interface{}
+	)
+	pipelineID := "94619026-912b-4d92-8f51-6c74f0692d90"
+	windows := []cdtektonpipelinev2.FreezeWindow{
+		{Name: "release", Start: "2025-12-20T18:00", End: "2025-12-27T09:00", Timezone: "Europe/Dublin", Tags: []string{"deploy"}},
+		{Name: "holiday", Start: "2025-12-24T00:00", End: "2026-01-02T00:00", Timezone: "America/New_York", Types: []string{"timer"}},
+	}
+
+	BeforeEach(func() {
+		triggers = map[string]map[string]interface{}{
+			"t1": {"id": "t1", "name": "deploy-prod", "type": "manual", "tags": []string{"deploy"}, "enabled": true},
+			"t2": {"id": "t2", "name": "nightly", "type": "timer", "tags": []string{"deploy"}, "enabled": true},
+			"t3": {"id": "t3", "name": "build", "type": "scm", "tags": []string{"ci"}, "enabled": true},
+			"t4": {"id": "t4", "name": "deploy-old", "type": "manual", "tags": []string{"deploy"}, "enabled": false},
+		}
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+			mutex.Lock()
+			defer mutex.Unlock()
+
+			res.Header().Set("Content-type", "application/json")
+			triggersPath := fmt.Sprintf("/tekton_pipelines/%s/triggers", pipelineID)
+			switch {
+			case req.Method == "GET" && req.URL.EscapedPath() == triggersPath:
+				Expect(req.URL.Query()["disabled"]).To(Equal([]string{"false"}))
+				list := []map[string]interface{}{}
+				for _, id := range []string{"t1", "t2", "t3", "t4"} {
+					if triggers[id]["enabled"] == true {
+						list = append(list, triggers[id])
+					}
+				}
+				_ = json.NewEncoder(res).Encode(map[string]interface{}{"triggers": list})
+			case req.Method == "PATCH" && strings.HasPrefix(req.URL.EscapedPath(), triggersPath+"/"):
+				id := strings.TrimPrefix(req.URL.EscapedPath(), triggersPath+"/")
+				var patch map[string]interface{}
+				Expect(json.NewDecoder(req.Body).Decode(&patch)).To(Succeed())
+				triggers[id]["enabled"] = patch["enabled"]
+				_ = json.NewEncoder(res).Encode(triggers[id])
+			default:
+				res.WriteHeader(404)
+			}
+		}))
+		var err error
+		cdTektonPipelineService, err = cdtektonpipelinev2.NewCdTektonPipelineV2(&cdtektonpipelinev2.CdTektonPipelineV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+		tempDir, err = os.MkdirTemp("", "freeze")
+		Expect(err).To(BeNil())
+		stateFile = filepath.Join(tempDir, "freeze-state.json")
+	})
+	AfterEach(func() {
+		testServer.Close()
+		os.RemoveAll(tempDir)
+	})
+
+	enabled := func(id string) bool {
+		mutex.Lock()
+		defer mutex.Unlock()
+		return triggers[id]["enabled"] == true
+	}
+	at := func(value string, timezone string) time.Time {
+		location, err := time.LoadLocation(timezone)
+		Expect(err).To(BeNil())
+		t, err := time.ParseInLocation(cdtektonpipelinev2.FreezeWindowTimeLayout, value, location)
+		Expect(err).To(BeNil())
+		return t
+	}
+
+	It(`Invoke NewFreezeScheduler with invalid options`, func() {
+		_, err := cdTektonPipelineService.NewFreezeScheduler(&cdtektonpipelinev2.FreezeSchedulerOptions{
+			Windows: windows,
+		})
+		Expect(err).ToNot(BeNil())
+
+		_, err = cdTektonPipelineService.NewFreezeScheduler(&cdtektonpipelinev2.FreezeSchedulerOptions{
+			Windows:   []cdtektonpipelinev2.FreezeWindow{{Name: "bad", Start: "2025-12-20T18:00", End: "2025-12-27T09:00", Timezone: "Mars/Olympus"}},
+			StateFile: stateFile,
+		})
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("Mars/Olympus"))
+
+		_, err = cdTektonPipelineService.NewFreezeScheduler(&cdtektonpipelinev2.FreezeSchedulerOptions{
+			Windows:   []cdtektonpipelinev2.FreezeWindow{windows[0], windows[0]},
+			StateFile: stateFile,
+		})
+		Expect(err).ToNot(BeNil())
+	})
+	It(`Freeze and restore triggers across overlapping windows and restarts`, func() {
+		options := &cdtektonpipelinev2.FreezeSchedulerOptions{
+			PipelineIDs: []string{pipelineID},
+			Windows:     windows,
+			StateFile:   stateFile,
+		}
+		scheduler, err := cdTektonPipelineService.NewFreezeScheduler(options)
+		Expect(err).To(BeNil())
+
+		// Before any window starts nothing changes.
+		Expect(scheduler.Reconcile(context.Background(), at("2025-12-20T17:59", "Europe/Dublin"))).To(Succeed())
+		Expect(enabled("t1")).To(BeTrue())
+
+		// The release window freezes every trigger tagged "deploy".
+		Expect(scheduler.Reconcile(context.Background(), at("2025-12-20T18:00", "Europe/Dublin"))).To(Succeed())
+		Expect(enabled("t1")).To(BeFalse())
+		Expect(enabled("t2")).To(BeFalse())
+		Expect(enabled("t3")).To(BeTrue())
+		Expect(scheduler.State().Windows["release"]).To(HaveLen(2))
+
+		// A restarted scheduler picks up what was disabled from the state file.
+		scheduler, err = cdTektonPipelineService.NewFreezeScheduler(options)
+		Expect(err).To(BeNil())
+		Expect(scheduler.State().Windows["release"]).To(HaveLen(2))
+
+		// When the release window ends the timer trigger stays frozen by the holiday window.
+		Expect(scheduler.Reconcile(context.Background(), at("2025-12-27T09:00", "Europe/Dublin"))).To(Succeed())
+		Expect(enabled("t1")).To(BeTrue())
+		Expect(enabled("t2")).To(BeFalse())
+		Expect(enabled("t4")).To(BeFalse())
+		state := scheduler.State()
+		Expect(state.Windows).ToNot(HaveKey("release"))
+		Expect(state.Windows["holiday"]).To(HaveLen(1))
+		Expect(state.Windows["holiday"][0].TriggerID).To(Equal("t2"))
+
+		Expect(scheduler.Reconcile(context.Background(), at("2026-01-02T00:00", "America/New_York"))).To(Succeed())
+		Expect(enabled("t2")).To(BeTrue())
+		Expect(scheduler.State().Windows).To(BeEmpty())
+
+		data, err := os.ReadFile(stateFile)
+		Expect(err).To(BeNil())
+		Expect(string(data)).To(ContainSubstring(`"windows": {}`))
+	})
+	It(`Record a trigger re-enabled during a window only once`, func() {
+		scheduler, err := cdTektonPipelineService.NewFreezeScheduler(&cdtektonpipelinev2.FreezeSchedulerOptions{
+			PipelineIDs: []string{pipelineID},
+			Windows:     windows,
+			StateFile:   stateFile,
+		})
+		Expect(err).To(BeNil())
+
+		Expect(scheduler.Reconcile(context.Background(), at("2025-12-20T18:00", "Europe/Dublin"))).To(Succeed())
+		Expect(scheduler.State().Windows["release"]).To(HaveLen(2))
+
+		mutex.Lock()
+		triggers["t1"]["enabled"] = true
+		mutex.Unlock()
+
+		Expect(scheduler.Reconcile(context.Background(), at("2025-12-21T18:00", "Europe/Dublin"))).To(Succeed())
+		Expect(enabled("t1")).To(BeFalse())
+		Expect(scheduler.State().Windows["release"]).To(HaveLen(2))
+	})
+	It(`Invoke Run with an invalid interval`, func() {
+		scheduler, err := cdTektonPipelineService.NewFreezeScheduler(&cdtektonpipelinev2.FreezeSchedulerOptions{
+			PipelineIDs: []string{pipelineID},
+			Windows:     windows,
+			StateFile:   stateFile,
+		})
+		Expect(err).To(BeNil())
+		err = scheduler.Run(context.Background(), 0, nil)
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("interval"))
+	})
+	It(`Invoke LoadFreezeCalendar successfully`, func() {
+		calendarFile := filepath.Join(tempDir, "calendar.json")
+		data, err := json.Marshal(windows)
+		Expect(err).To(BeNil())
+		Expect(os.WriteFile(calendarFile, data, 0600)).To(Succeed())
+
+		calendar, err := cdtektonpipelinev2.LoadFreezeCalendar(calendarFile)
+		Expect(err).To(BeNil())
+		Expect(calendar).To(Equal(windows))
+		start, end, err := calendar[0].Bounds()
+		Expect(err).To(BeNil())
+		Expect(start.UTC().Format(time.RFC3339)).To(Equal("2025-12-20T18:00:00Z"))
+		Expect(end.UTC().Format(time.RFC3339)).To(Equal("2025-12-27T09:00:00Z"))
+	})
+})
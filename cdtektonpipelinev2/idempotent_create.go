@@ -0,0 +1,180 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtektonpipelinev2
+
+import (
+	"context"
+	"maps"
+	"strings"
+	"time"
+
+	common "github.com/IBM/continuous-delivery-go-sdk/v2/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// IdempotencyKeyProperty is the trigger property that carries the idempotency key of the pipeline
+// runs created by CreateTektonPipelineRunIdempotent. The property is added to every such run and,
+// like any other trigger property, is listed in the properties of the run and is visible to its
+// tasks as an environment property. A pipeline that must not receive it should be run with
+// CreateTektonPipelineRun instead.
+const IdempotencyKeyProperty = "idempotency_key"
+
+// CreateTektonPipelineRunIdempotent invokes CreateTektonPipelineRunIdempotentWithContext using
+// context.Background().
+func (cdTektonPipeline *CdTektonPipelineV2) CreateTektonPipelineRunIdempotent(createTektonPipelineRunOptions *CreateTektonPipelineRunOptions, idempotencyOptions *common.IdempotencyOptions) (result *PipelineRun, response *core.DetailedResponse, err error) {
+	result, response, err = cdTektonPipeline.CreateTektonPipelineRunIdempotentWithContext(context.Background(), createTektonPipelineRunOptions, idempotencyOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// CreateTektonPipelineRunIdempotentWithContext creates a pipeline run like
+// CreateTektonPipelineRunWithContext, except that a failure leaving unknown whether the run was
+// created is retried as described by common.CreateIdempotently. The idempotency key is passed to
+// the run as the IdempotencyKeyProperty trigger property, and a run created since the first
+// attempt that has this property, or whose description contains the key, is returned instead of a
+// duplicate. Retries enabled by EnableRetries are not used, as they would bypass the lookup.
+// idempotencyOptions may be nil.
+func (cdTektonPipeline *CdTektonPipelineV2) CreateTektonPipelineRunIdempotentWithContext(ctx context.Context, createTektonPipelineRunOptions *CreateTektonPipelineRunOptions, idempotencyOptions *common.IdempotencyOptions) (result *PipelineRun, response *core.DetailedResponse, err error) {
+	err = core.ValidateNotNil(createTektonPipelineRunOptions, "createTektonPipelineRunOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(createTektonPipelineRunOptions, "createTektonPipelineRunOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+	key := common.NewIdempotencyKey()
+	if idempotencyOptions != nil && idempotencyOptions.Key != "" {
+		key = idempotencyOptions.Key
+	}
+	runOptions := *createTektonPipelineRunOptions
+	runOptions.TriggerProperties = maps.Clone(runOptions.TriggerProperties)
+	if runOptions.TriggerProperties == nil {
+		runOptions.TriggerProperties = map[string]interface{}{}
+	}
+	runOptions.TriggerProperties[IdempotencyKeyProperty] = key
+	service := cdTektonPipeline.withoutRetries()
+	since := time.Now().Add(-common.IdempotencyClockSkew)
+
+	return common.CreateIdempotently(ctx, idempotencyOptions,
+		func(ctx context.Context) (*PipelineRun, *core.DetailedResponse, error) {
+			return service.CreateTektonPipelineRunWithContext(ctx, &runOptions)
+		},
+		func(ctx context.Context) (*PipelineRun, bool, error) {
+			query := &TektonPipelineRunQuery{PipelineID: *runOptions.PipelineID, CreatedAfter: since}
+			for run, err := range service.QueryTektonPipelineRuns(ctx, query) {
+				if err != nil {
+					return nil, false, err
+				}
+				if hasIdempotencyKey(&run, key) {
+					// The run was created by an attempt that appeared to fail.
+					cdTektonPipeline.runEvents().watch(&run)
+					return &run, true, nil
+				}
+			}
+			return nil, false, nil
+		})
+}
+
+// hasIdempotencyKey returns true if a pipeline run is marked with key.
+func hasIdempotencyKey(run *PipelineRun, key string) bool {
+	if strings.Contains(core.StringNilMapper(run.Description), key) {
+		return true
+	}
+	for _, property := range run.Properties {
+		if core.StringNilMapper(property.Name) == IdempotencyKeyProperty && core.StringNilMapper(property.Value) == key {
+			return true
+		}
+	}
+	return false
+}
+
+// CreateTektonPipelineTriggerIdempotent invokes CreateTektonPipelineTriggerIdempotentWithContext
+// using context.Background().
+func (cdTektonPipeline *CdTektonPipelineV2) CreateTektonPipelineTriggerIdempotent(createTektonPipelineTriggerOptions *CreateTektonPipelineTriggerOptions, idempotencyOptions *common.IdempotencyOptions) (result TriggerIntf, response *core.DetailedResponse, err error) {
+	result, response, err = cdTektonPipeline.CreateTektonPipelineTriggerIdempotentWithContext(context.Background(), createTektonPipelineTriggerOptions, idempotencyOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// CreateTektonPipelineTriggerIdempotentWithContext creates a trigger like
+// CreateTektonPipelineTriggerWithContext, except that a failure leaving unknown whether the
+// trigger was created is retried as described by common.CreateIdempotently. As trigger names are
+// unique within a pipeline, a trigger of the same name and type that did not exist before the
+// first attempt is returned instead of a duplicate. Retries enabled by EnableRetries are not
+// used, as they would bypass the lookup. idempotencyOptions may be nil.
+func (cdTektonPipeline *CdTektonPipelineV2) CreateTektonPipelineTriggerIdempotentWithContext(ctx context.Context, createTektonPipelineTriggerOptions *CreateTektonPipelineTriggerOptions, idempotencyOptions *common.IdempotencyOptions) (result TriggerIntf, response *core.DetailedResponse, err error) {
+	err = core.ValidateNotNil(createTektonPipelineTriggerOptions, "createTektonPipelineTriggerOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(createTektonPipelineTriggerOptions, "createTektonPipelineTriggerOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+	service := cdTektonPipeline.withoutRetries()
+
+	// findTriggers calls yield with each trigger of the same name and type.
+	findTriggers := func(ctx context.Context, yield func(trigger TriggerIntf, id string)) error {
+		listOptions := service.NewListTektonPipelineTriggersOptions(*createTektonPipelineTriggerOptions.PipelineID)
+		listOptions.SetName(*createTektonPipelineTriggerOptions.Name)
+		triggers, _, err := service.ListTektonPipelineTriggersWithContext(ctx, listOptions)
+		if err != nil {
+			return err
+		}
+		for _, trigger := range triggers.Triggers {
+			generic := AsTrigger(trigger)
+			if generic != nil && core.StringNilMapper(generic.Name) == *createTektonPipelineTriggerOptions.Name &&
+				core.StringNilMapper(generic.Type) == *createTektonPipelineTriggerOptions.Type {
+				yield(trigger, core.StringNilMapper(generic.ID))
+			}
+		}
+		return nil
+	}
+	existing := map[string]bool{}
+	err = findTriggers(ctx, func(trigger TriggerIntf, id string) {
+		existing[id] = true
+	})
+	if err != nil {
+		err = core.SDKErrorf(err, "", "list-triggers-error", common.GetComponentInfo())
+		return
+	}
+
+	return common.CreateIdempotently(ctx, idempotencyOptions,
+		func(ctx context.Context) (TriggerIntf, *core.DetailedResponse, error) {
+			return service.CreateTektonPipelineTriggerWithContext(ctx, createTektonPipelineTriggerOptions)
+		},
+		func(ctx context.Context) (created TriggerIntf, found bool, err error) {
+			err = findTriggers(ctx, func(trigger TriggerIntf, id string) {
+				if !found && !existing[id] {
+					created, found = trigger, true
+				}
+			})
+			return
+		})
+}
+
+// withoutRetries returns a clone of this service instance with automatic retries disabled.
+func (cdTektonPipeline *CdTektonPipelineV2) withoutRetries() *CdTektonPipelineV2 {
+	clone := cdTektonPipeline.Clone()
+	clone.DisableRetries()
+	return clone
+}
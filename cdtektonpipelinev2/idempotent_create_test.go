@@ -0,0 +1,130 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtektonpipelinev2_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtektonpipelinev2"
+	common "github.com/IBM/continuous-delivery-go-sdk/v2/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Idempotent create operations`, func() {
+	var (
+		testServer              *httptest.Server
+		cdTektonPipelineService *cdtektonpipelinev2.CdTektonPipelineV2
+		requests                []string
+		runKeys                 []string
+		storedKeys              []string
+		triggerExists           bool
+	)
+	idempotencyOptions := &common.IdempotencyOptions{Key: "k1", RetryInterval: time.Millisecond}
+
+	BeforeEach(func() {
+		requests, runKeys, storedKeys, triggerExists = nil, nil, nil, false
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+			requests = append(requests, req.Method+" "+req.URL.Path)
+			res.Header().Set("Content-type", "application/json")
+			switch req.Method + " " + req.URL.Path {
+			case "POST /tekton_pipelines/p1/pipeline_runs":
+				var body struct {
+					TriggerProperties map[string]string `json:"trigger_properties"`
+				}
+				Expect(json.NewDecoder(req.Body).Decode(&body)).To(Succeed())
+				runKeys = append(runKeys, body.TriggerProperties[cdtektonpipelinev2.IdempotencyKeyProperty])
+				// Runs with the key k2 fail before being created.
+				if key := runKeys[len(runKeys)-1]; key != "k2" {
+					storedKeys = append(storedKeys, key)
+				}
+				res.WriteHeader(504)
+				fmt.Fprint(res, `{"errors": [{"code": "timeout", "message": "Gateway timeout"}]}`)
+			case "GET /tekton_pipelines/p1/pipeline_runs":
+				runs := []map[string]interface{}{}
+				for i, key := range storedKeys {
+					runs = append(runs, map[string]interface{}{
+						"id": fmt.Sprintf("r%d", i), "created_at": time.Now().UTC().Format(time.RFC3339),
+						"properties": []map[string]string{{"name": cdtektonpipelinev2.IdempotencyKeyProperty, "type": "text", "value": key}},
+					})
+				}
+				Expect(json.NewEncoder(res).Encode(map[string]interface{}{"pipeline_runs": runs})).To(Succeed())
+			case "POST /tekton_pipelines/p1/triggers":
+				// The trigger is created, but the response is lost.
+				if triggerExists {
+					res.WriteHeader(409)
+					fmt.Fprint(res, `{"errors": [{"code": "conflict", "message": "Trigger name already exists"}]}`)
+					return
+				}
+				triggerExists = true
+				res.WriteHeader(502)
+				fmt.Fprint(res, `{"errors": [{"code": "bad_gateway", "message": "Bad gateway"}]}`)
+			case "GET /tekton_pipelines/p1/triggers":
+				Expect(req.URL.Query().Get("name")).To(Equal("nightly"))
+				if !triggerExists {
+					fmt.Fprint(res, `{"triggers": []}`)
+					return
+				}
+				fmt.Fprint(res, `{"triggers": [{"id": "t1", "type": "timer", "name": "nightly", "event_listener": "l"}]}`)
+			}
+		}))
+		var err error
+		cdTektonPipelineService, err = cdtektonpipelinev2.NewCdTektonPipelineV2(&cdtektonpipelinev2.CdTektonPipelineV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Return the pipeline run marked with the idempotency key`, func() {
+		createOptions := cdTektonPipelineService.NewCreateTektonPipelineRunOptions("p1")
+		createOptions.SetTriggerProperties(map[string]interface{}{"env": "prod"})
+		result, _, err := cdTektonPipelineService.CreateTektonPipelineRunIdempotent(createOptions, idempotencyOptions)
+		Expect(err).To(BeNil())
+		Expect(*result.ID).To(Equal("r0"))
+		Expect(runKeys).To(Equal([]string{"k1"}))
+		Expect(createOptions.TriggerProperties).To(Equal(map[string]interface{}{"env": "prod"}))
+		Expect(requests).To(HaveLen(2))
+
+		// The run marked with k1 is not mistaken for a run marked with k2.
+		_, _, err = cdTektonPipelineService.CreateTektonPipelineRunIdempotent(createOptions, &common.IdempotencyOptions{Key: "k2", MaxAttempts: 2, RetryInterval: time.Millisecond})
+		Expect(err).ToNot(BeNil())
+		Expect(runKeys).To(Equal([]string{"k1", "k2", "k2"}))
+	})
+	It(`Return the trigger of the same name`, func() {
+		createOptions := cdTektonPipelineService.NewCreateTektonPipelineTriggerOptions("p1", "timer", "nightly", "l")
+		result, response, err := cdTektonPipelineService.CreateTektonPipelineTriggerIdempotent(createOptions, nil)
+		Expect(err).To(BeNil())
+		Expect(response.StatusCode).To(Equal(200))
+		Expect(*cdtektonpipelinev2.AsTrigger(result).ID).To(Equal("t1"))
+		Expect(requests).To(Equal([]string{"GET /tekton_pipelines/p1/triggers", "POST /tekton_pipelines/p1/triggers", "GET /tekton_pipelines/p1/triggers"}))
+
+		// A trigger that existed before the first attempt is not returned.
+		_, response, err = cdTektonPipelineService.CreateTektonPipelineTriggerIdempotent(createOptions, nil)
+		Expect(err).ToNot(BeNil())
+		Expect(response.StatusCode).To(Equal(409))
+	})
+})
@@ -0,0 +1,265 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtektonpipelinev2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtoolchainv2"
+	common "github.com/IBM/continuous-delivery-go-sdk/v2/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Constants associated with the IntegrationIssue.Code property.
+const (
+	IntegrationIssueCodeMissingToolIDConst     = "missing_tool_id"
+	IntegrationIssueCodeToolNotFoundConst      = "tool_not_found"
+	IntegrationIssueCodeToolNotConfiguredConst = "tool_not_configured"
+	IntegrationIssueCodePathNotFoundConst      = "path_not_found"
+)
+
+// IntegrationIssue : A problem found with an `integration` property.
+type IntegrationIssue struct {
+	// The property name.
+	PropertyName string `json:"property_name"`
+
+	// The trigger ID, if the property is a trigger property.
+	TriggerID string `json:"trigger_id,omitempty"`
+
+	// The tool ID held by the property.
+	ToolID string `json:"tool_id,omitempty"`
+
+	// The property path.
+	Path string `json:"path,omitempty"`
+
+	// One of the IntegrationIssueCode constants.
+	Code string `json:"code"`
+
+	// A description of the problem.
+	Message string `json:"message"`
+}
+
+// IntegrationValidationReport : The result of validating a pipeline's `integration` properties.
+type IntegrationValidationReport struct {
+	PipelineID  string             `json:"pipeline_id"`
+	ToolchainID string             `json:"toolchain_id"`
+	Checked     int                `json:"checked"`
+	Issues      []IntegrationIssue `json:"issues"`
+}
+
+// Valid returns true if no issues were found.
+func (report *IntegrationValidationReport) Valid() bool {
+	return len(report.Issues) == 0
+}
+
+// ValidateIntegrationPropertiesOptions : The ValidateIntegrationProperties options.
+type ValidateIntegrationPropertiesOptions struct {
+	// The Tekton pipeline ID.
+	PipelineID string
+
+	// Client for the toolchain service of the pipeline's parent toolchain.
+	ToolchainClient *cdtoolchainv2.CdToolchainV2
+
+	// When true, the properties of the pipeline's triggers are validated as well.
+	IncludeTriggers bool
+}
+
+// ValidateIntegrationProperties invokes ValidateIntegrationPropertiesWithContext using context.Background().
+func (cdTektonPipeline *CdTektonPipelineV2) ValidateIntegrationProperties(options *ValidateIntegrationPropertiesOptions) (report *IntegrationValidationReport, err error) {
+	report, err = cdTektonPipeline.ValidateIntegrationPropertiesWithContext(context.Background(), options)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// ValidateIntegrationPropertiesWithContext checks every `integration` property of the pipeline
+// against the tools of its parent toolchain: the referenced tool must exist and be `configured`,
+// and the property's Path, if any, must resolve against the tool's data.
+//
+// A Path is resolved against the tool as returned by GetToolByID, so "parameters.repo_url"
+// selects the repo_url parameter. A Path is resolved against the tool's Parameters first, so
+// "repo_url" selects the same parameter, and against the top-level tool fields if no parameter
+// matches it.
+func (cdTektonPipeline *CdTektonPipelineV2) ValidateIntegrationPropertiesWithContext(ctx context.Context, options *ValidateIntegrationPropertiesOptions) (report *IntegrationValidationReport, err error) {
+	err = core.ValidateNotNil(options, "options cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	if options.PipelineID == "" || options.ToolchainClient == nil {
+		err = core.SDKErrorf(nil, "the 'options.PipelineID' and 'options.ToolchainClient' fields must be set", "missing-required-option", common.GetComponentInfo())
+		return
+	}
+
+	pipeline, _, err := cdTektonPipeline.GetTektonPipelineWithContext(ctx, cdTektonPipeline.NewGetTektonPipelineOptions(options.PipelineID))
+	if err != nil {
+		return
+	}
+	if pipeline.Toolchain == nil || pipeline.Toolchain.ID == nil {
+		err = core.SDKErrorf(nil, fmt.Sprintf("pipeline '%s' has no parent toolchain", options.PipelineID), "missing-toolchain", common.GetComponentInfo())
+		return
+	}
+
+	tools := newToolLookup(options.ToolchainClient, *pipeline.Toolchain.ID)
+	report = &IntegrationValidationReport{
+		PipelineID:  options.PipelineID,
+		ToolchainID: *pipeline.Toolchain.ID,
+		Issues:      []IntegrationIssue{},
+	}
+	for i := range pipeline.Properties {
+		if err = report.check(ctx, tools, &pipeline.Properties[i], ""); err != nil {
+			return nil, err
+		}
+	}
+	if options.IncludeTriggers {
+		for _, item := range pipeline.Triggers {
+			trigger := AsTrigger(item)
+			if trigger == nil {
+				continue
+			}
+			for i := range trigger.Properties {
+				property := trigger.Properties[i].AsProperty()
+				if err = report.check(ctx, tools, &property, core.StringNilMapper(trigger.ID)); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+	return
+}
+
+func (report *IntegrationValidationReport) check(ctx context.Context, tools *toolLookup, property *Property, triggerID string) error {
+	if core.StringNilMapper(property.Type) != PropertyTypeIntegrationConst {
+		return nil
+	}
+	report.Checked++
+	issue := IntegrationIssue{
+		PropertyName: core.StringNilMapper(property.Name),
+		TriggerID:    triggerID,
+		ToolID:       core.StringNilMapper(property.Value),
+		Path:         core.StringNilMapper(property.Path),
+	}
+	if issue.ToolID == "" {
+		issue.Code = IntegrationIssueCodeMissingToolIDConst
+		issue.Message = "the property does not reference a tool"
+		report.Issues = append(report.Issues, issue)
+		return nil
+	}
+
+	tool, err := tools.get(ctx, issue.ToolID)
+	if err != nil {
+		return err
+	}
+	switch {
+	case tool == nil:
+		issue.Code = IntegrationIssueCodeToolNotFoundConst
+		issue.Message = fmt.Sprintf("tool '%s' does not exist in toolchain '%s'", issue.ToolID, report.ToolchainID)
+	case core.StringNilMapper(tool.State) != cdtoolchainv2.ToolchainToolStateConfiguredConst:
+		issue.Code = IntegrationIssueCodeToolNotConfiguredConst
+		issue.Message = fmt.Sprintf("tool '%s' is in state '%s'", issue.ToolID, core.StringNilMapper(tool.State))
+	case issue.Path != "":
+		if _, found := ResolveToolPath(tool, issue.Path); !found {
+			issue.Code = IntegrationIssueCodePathNotFoundConst
+			issue.Message = fmt.Sprintf("path '%s' does not resolve in tool '%s'", issue.Path, issue.ToolID)
+		}
+	}
+	if issue.Code != "" {
+		report.Issues = append(report.Issues, issue)
+	}
+	return nil
+}
+
+// toolLookup fetches each tool of a toolchain at most once.
+type toolLookup struct {
+	client      *cdtoolchainv2.CdToolchainV2
+	toolchainID string
+	tools       map[string]*cdtoolchainv2.ToolchainTool
+}
+
+func newToolLookup(client *cdtoolchainv2.CdToolchainV2, toolchainID string) *toolLookup {
+	return &toolLookup{client: client, toolchainID: toolchainID, tools: map[string]*cdtoolchainv2.ToolchainTool{}}
+}
+
+// get returns the tool, or nil if it does not exist.
+func (lookup *toolLookup) get(ctx context.Context, toolID string) (*cdtoolchainv2.ToolchainTool, error) {
+	if tool, ok := lookup.tools[toolID]; ok {
+		return tool, nil
+	}
+	tool, _, err := lookup.client.GetToolByIDWithContext(ctx, lookup.client.NewGetToolByIDOptions(lookup.toolchainID, toolID))
+	if err != nil && !errors.Is(err, common.ErrNotFound) {
+		return nil, err
+	}
+	lookup.tools[toolID] = tool
+	return tool, nil
+}
+
+// toolData returns the generic representation of a tool that integration property paths select from.
+func toolData(tool *cdtoolchainv2.ToolchainTool) map[string]interface{} {
+	data := map[string]interface{}{
+		"id":           core.StringNilMapper(tool.ID),
+		"name":         core.StringNilMapper(tool.Name),
+		"tool_type_id": core.StringNilMapper(tool.ToolTypeID),
+		"toolchain_id": core.StringNilMapper(tool.ToolchainID),
+		"state":        core.StringNilMapper(tool.State),
+		"parameters":   tool.Parameters,
+	}
+	if tool.Parameters == nil {
+		data["parameters"] = map[string]interface{}{}
+	}
+	return data
+}
+
+// ResolveToolPath resolves a dot notation path, as used by `integration` properties, against
+// the tool. The path is resolved against the tool's Parameters first, and
+// against the top-level tool fields if no parameter matches it: "name" selects a parameter named
+// name if there is one, and the tool name otherwise. An empty path selects the whole tool data.
+func ResolveToolPath(tool *cdtoolchainv2.ToolchainTool, path string) (value interface{}, found bool) {
+	data := toolData(tool)
+	if path == "" {
+		return data, true
+	}
+	segments := strings.Split(path, ".")
+	if value, found = resolvePath(data["parameters"], segments); found {
+		return
+	}
+	return resolvePath(data, segments)
+}
+
+func resolvePath(value interface{}, segments []string) (interface{}, bool) {
+	for _, segment := range segments {
+		switch current := value.(type) {
+		case map[string]interface{}:
+			next, ok := current[segment]
+			if !ok {
+				return nil, false
+			}
+			value = next
+		case []interface{}:
+			index, err := strconv.Atoi(segment)
+			if err != nil || index < 0 || index >= len(current) {
+				return nil, false
+			}
+			value = current[index]
+		default:
+			return nil, false
+		}
+	}
+	return value, true
+}
@@ -0,0 +1,157 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtektonpipelinev2_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtektonpipelinev2"
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtoolchainv2"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// mockToolchainTools is the GetToolByID response body of each tool in toolchain "tc1" used by the tests.
+var mockToolchainTools = map[string]string{
+	"repo": `{"id": "repo", "name": "app-repo", "tool_type_id": "githubconsolidated", "toolchain_id": "tc1", "state": "configured",
+		"parameters": {"repo_url": "https://github.com/org/app", "token_url": "https://token", "owners": ["alice", "bob"], "api_key": "abc123"}}`,
+	"slack": `{"id": "slack", "name": "chat", "tool_type_id": "slack", "toolchain_id": "tc1", "state": "misconfigured", "parameters": {}}`,
+}
+
+// newMockToolchainServer returns a test server answering GetToolByID and ListTools for mockToolchainTools.
+func newMockToolchainServer() *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		defer GinkgoRecover()
+		res.Header().Set("Content-type", "application/json")
+		if req.Method == "GET" && req.URL.EscapedPath() == "/toolchains/tc1/tools" {
+			fmt.Fprintf(res, `{"limit": 20, "total_count": 2, "first": {"href": "/toolchains/tc1/tools"}, "tools": [%s, %s]}`,
+				mockToolchainTools["repo"], mockToolchainTools["slack"])
+			return
+		}
+		for id, body := range mockToolchainTools {
+			if req.Method == "GET" && req.URL.EscapedPath() == "/toolchains/tc1/tools/"+id {
+				fmt.Fprint(res, body)
+				return
+			}
+		}
+		res.WriteHeader(404)
+		fmt.Fprint(res, `{"errors": [{"code": "not_found", "message": "tool not found"}], "status_code": 404}`)
+	}))
+}
+
+var _ = Describe(`ValidateIntegrationProperties`, func() {
+	var (
+		pipelineServer          *httptest.Server
+		toolchainServer         *httptest.Server
+		cdTektonPipelineService *cdtektonpipelinev2.CdTektonPipelineV2
+		cdToolchainService      *cdtoolchainv2.CdToolchainV2
+	)
+
+	BeforeEach(func() {
+		pipelineServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+			Expect(req.URL.EscapedPath()).To(Equal("/tekton_pipelines/p1"))
+			res.Header().Set("Content-type", "application/json")
+			fmt.Fprint(res, `{"id": "p1", "toolchain": {"id": "tc1", "crn": "crn:tc1"}, "properties": [
+				{"name": "plain", "type": "text", "value": "x"},
+				{"name": "repo", "type": "integration", "value": "repo"},
+				{"name": "repo-url", "type": "integration", "value": "repo", "path": "parameters.repo_url"},
+				{"name": "owner", "type": "integration", "value": "repo", "path": "owners.1"},
+				{"name": "bad-path", "type": "integration", "value": "repo", "path": "parameters.nope"},
+				{"name": "missing", "type": "integration", "value": "gone"},
+				{"name": "broken", "type": "integration", "value": "slack"},
+				{"name": "empty", "type": "integration"}],
+				"triggers": [{"id": "t1", "type": "manual", "properties": [{"name": "trigger-path", "type": "integration", "value": "repo", "path": "owners.5"}]}]}`)
+		}))
+		toolchainServer = newMockToolchainServer()
+
+		var err error
+		cdTektonPipelineService, err = cdtektonpipelinev2.NewCdTektonPipelineV2(&cdtektonpipelinev2.CdTektonPipelineV2Options{
+			URL:           pipelineServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+		cdToolchainService, err = cdtoolchainv2.NewCdToolchainV2(&cdtoolchainv2.CdToolchainV2Options{
+			URL:           toolchainServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+	})
+	AfterEach(func() {
+		pipelineServer.Close()
+		toolchainServer.Close()
+	})
+
+	It(`Invoke ValidateIntegrationProperties successfully`, func() {
+		report, err := cdTektonPipelineService.ValidateIntegrationProperties(&cdtektonpipelinev2.ValidateIntegrationPropertiesOptions{
+			PipelineID:      "p1",
+			ToolchainClient: cdToolchainService,
+			IncludeTriggers: true,
+		})
+		Expect(err).To(BeNil())
+		Expect(report.Valid()).To(BeFalse())
+		Expect(report.ToolchainID).To(Equal("tc1"))
+		Expect(report.Checked).To(Equal(8))
+
+		codes := map[string]string{}
+		for _, issue := range report.Issues {
+			codes[issue.PropertyName] = issue.Code
+		}
+		Expect(codes).To(Equal(map[string]string{
+			"bad-path":     cdtektonpipelinev2.IntegrationIssueCodePathNotFoundConst,
+			"missing":      cdtektonpipelinev2.IntegrationIssueCodeToolNotFoundConst,
+			"broken":       cdtektonpipelinev2.IntegrationIssueCodeToolNotConfiguredConst,
+			"empty":        cdtektonpipelinev2.IntegrationIssueCodeMissingToolIDConst,
+			"trigger-path": cdtektonpipelinev2.IntegrationIssueCodePathNotFoundConst,
+		}))
+		Expect(report.Issues[len(report.Issues)-1].TriggerID).To(Equal("t1"))
+	})
+	It(`Invoke ValidateIntegrationProperties with invalid options`, func() {
+		_, err := cdTektonPipelineService.ValidateIntegrationProperties(&cdtektonpipelinev2.ValidateIntegrationPropertiesOptions{PipelineID: "p1"})
+		Expect(err).ToNot(BeNil())
+	})
+	It(`Invoke ResolveToolPath successfully`, func() {
+		tool := &cdtoolchainv2.ToolchainTool{
+			ID:         core.StringPtr("repo"),
+			Name:       core.StringPtr("app-repo"),
+			Parameters: map[string]interface{}{"repo_url": "https://github.com/org/app", "nested": map[string]interface{}{"key": "v"}},
+		}
+		value, found := cdtektonpipelinev2.ResolveToolPath(tool, "parameters.nested.key")
+		Expect(found).To(BeTrue())
+		Expect(value).To(Equal("v"))
+		value, found = cdtektonpipelinev2.ResolveToolPath(tool, "repo_url")
+		Expect(found).To(BeTrue())
+		Expect(value).To(Equal("https://github.com/org/app"))
+		value, found = cdtektonpipelinev2.ResolveToolPath(tool, "name")
+		Expect(found).To(BeTrue())
+		Expect(value).To(Equal("app-repo"))
+		_, found = cdtektonpipelinev2.ResolveToolPath(tool, "nested.key.deeper")
+		Expect(found).To(BeFalse())
+
+		// A parameter takes precedence over the top-level tool field of the same name.
+		tool.Parameters["name"] = "repo-param"
+		value, found = cdtektonpipelinev2.ResolveToolPath(tool, "name")
+		Expect(found).To(BeTrue())
+		Expect(value).To(Equal("repo-param"))
+		value, found = cdtektonpipelinev2.ResolveToolPath(tool, "id")
+		Expect(found).To(BeTrue())
+		Expect(value).To(Equal("repo"))
+	})
+})
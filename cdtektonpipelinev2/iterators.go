@@ -0,0 +1,52 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtektonpipelinev2
+
+import (
+	"context"
+	"iter"
+
+	common "github.com/IBM/continuous-delivery-go-sdk/v2/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Items returns an iterator over the remaining pipeline runs of the pager. Pages are fetched
+// while the loop runs, and no further page is fetched once it breaks. An error ends the iteration.
+func (pager *TektonPipelineRunsPager) Items(ctx context.Context) iter.Seq2[PipelineRun, error] {
+	return common.PageItems(ctx, pager.HasNext, pager.GetNextWithContext)
+}
+
+// PrefetchItems is like Items, except that pages are fetched by a background goroutine that runs
+// ahead of the loop within the bounds of options, which may be nil. The pager must not be used
+// otherwise while the loop runs.
+func (pager *TektonPipelineRunsPager) PrefetchItems(ctx context.Context, options *common.PrefetchOptions) iter.Seq2[PipelineRun, error] {
+	return common.PrefetchItems(ctx, pager.HasNext, pager.GetNextWithContext, options)
+}
+
+// TektonPipelineRuns returns an iterator over the pipeline runs matching options, as described
+// by TektonPipelineRunsPager.Items.
+func (cdTektonPipeline *CdTektonPipelineV2) TektonPipelineRuns(ctx context.Context, options *ListTektonPipelineRunsOptions) iter.Seq2[PipelineRun, error] {
+	err := core.ValidateNotNil(options, "options cannot be nil")
+	if err != nil {
+		return common.ErrorItems[PipelineRun](core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo()))
+	}
+	pager, err := cdTektonPipeline.NewTektonPipelineRunsPager(options)
+	if err != nil {
+		return common.ErrorItems[PipelineRun](err)
+	}
+	return pager.Items(ctx)
+}
@@ -0,0 +1,102 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtektonpipelinev2_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtektonpipelinev2"
+	common "github.com/IBM/continuous-delivery-go-sdk/v2/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Iterators`, func() {
+	var (
+		testServer              *httptest.Server
+		cdTektonPipelineService *cdtektonpipelinev2.CdTektonPipelineV2
+		requests                int
+	)
+
+	BeforeEach(func() {
+		requests = 0
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+			Expect(req.URL.Path).To(Equal("/tekton_pipelines/p1/pipeline_runs"))
+			requests++
+			res.Header().Set("Content-type", "application/json")
+			if req.URL.Query().Get("start") == "" {
+				fmt.Fprintf(res, `{"pipeline_runs": [{"id": "r1"}, {"id": "r2"}], "limit": 2, "first": {"href": "%[1]s"}, "next": {"href": "%[1]s?start=s2"}}`, testServer.URL)
+				return
+			}
+			fmt.Fprintf(res, `{"pipeline_runs": [{"id": "r3"}], "limit": 2, "first": {"href": "%s"}}`, testServer.URL)
+		}))
+		var err error
+		cdTektonPipelineService, err = cdtektonpipelinev2.NewCdTektonPipelineV2(&cdtektonpipelinev2.CdTektonPipelineV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Iterate over pipeline runs`, func() {
+		var ids []string
+		for run, err := range cdTektonPipelineService.TektonPipelineRuns(context.Background(), cdTektonPipelineService.NewListTektonPipelineRunsOptions("p1")) {
+			Expect(err).To(BeNil())
+			ids = append(ids, *run.ID)
+		}
+		Expect(ids).To(Equal([]string{"r1", "r2", "r3"}))
+		Expect(requests).To(Equal(2))
+
+		requests = 0
+		for range cdTektonPipelineService.TektonPipelineRuns(context.Background(), cdTektonPipelineService.NewListTektonPipelineRunsOptions("p1")) {
+			break
+		}
+		Expect(requests).To(Equal(1))
+
+		for _, err := range cdTektonPipelineService.TektonPipelineRuns(context.Background(), nil) {
+			Expect(err).ToNot(BeNil())
+		}
+	})
+	It(`Prefetch pipeline runs`, func() {
+		pager, err := cdTektonPipelineService.NewTektonPipelineRunsPager(cdTektonPipelineService.NewListTektonPipelineRunsOptions("p1"))
+		Expect(err).To(BeNil())
+		var ids []string
+		for run, err := range pager.PrefetchItems(context.Background(), &common.PrefetchOptions{Depth: 2}) {
+			Expect(err).To(BeNil())
+			ids = append(ids, *run.ID)
+		}
+		Expect(ids).To(Equal([]string{"r1", "r2", "r3"}))
+		Expect(requests).To(Equal(2))
+		Expect(pager.HasNext()).To(BeFalse())
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		pager, err = cdTektonPipelineService.NewTektonPipelineRunsPager(cdTektonPipelineService.NewListTektonPipelineRunsOptions("p1"))
+		Expect(err).To(BeNil())
+		for _, err := range pager.PrefetchItems(ctx, nil) {
+			Expect(err).ToNot(BeNil())
+		}
+	})
+})
@@ -0,0 +1,397 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtektonpipelinev2
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	common "github.com/IBM/continuous-delivery-go-sdk/v2/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"gopkg.in/yaml.v3"
+)
+
+// Supported property file formats.
+const (
+	PropertyFormatDotenvConst = "dotenv"
+	PropertyFormatJSONConst   = "json"
+	PropertyFormatYAMLConst   = "yaml"
+)
+
+// PropertyFormatFromPath returns the property file format implied by the file's extension.
+func PropertyFormatFromPath(path string) (format string, err error) {
+	switch ext := strings.ToLower(filepath.Ext(path)); {
+	case ext == ".json":
+		format = PropertyFormatJSONConst
+	case ext == ".yaml" || ext == ".yml":
+		format = PropertyFormatYAMLConst
+	case ext == ".env" || strings.HasPrefix(filepath.Base(path), ".env"):
+		format = PropertyFormatDotenvConst
+	default:
+		err = core.SDKErrorf(nil, fmt.Sprintf("unable to determine the property file format of '%s'", path), "unknown-property-format", common.GetComponentInfo())
+	}
+	return
+}
+
+// propertyFileEntry is the representation of a pipeline or trigger property in a property file.
+type propertyFileEntry struct {
+	Name   string   `json:"name" yaml:"name"`
+	Value  *string  `json:"value,omitempty" yaml:"value,omitempty"`
+	Type   string   `json:"type" yaml:"type"`
+	Enum   []string `json:"enum,omitempty" yaml:"enum,omitempty"`
+	Locked *bool    `json:"locked,omitempty" yaml:"locked,omitempty"`
+	Path   *string  `json:"path,omitempty" yaml:"path,omitempty"`
+}
+
+type propertyFile struct {
+	Properties []propertyFileEntry `json:"properties" yaml:"properties"`
+}
+
+func (entry *propertyFileEntry) asProperty() Property {
+	return Property{
+		Name:   core.StringPtr(entry.Name),
+		Value:  entry.Value,
+		Type:   core.StringPtr(entry.Type),
+		Enum:   entry.Enum,
+		Locked: entry.Locked,
+		Path:   entry.Path,
+	}
+}
+
+func propertyEntries(properties []Property) []propertyFileEntry {
+	entries := make([]propertyFileEntry, 0, len(properties))
+	for _, property := range properties {
+		entries = append(entries, propertyFileEntry{
+			Name:   core.StringNilMapper(property.Name),
+			Value:  property.Value,
+			Type:   core.StringNilMapper(property.Type),
+			Enum:   property.Enum,
+			Locked: property.Locked,
+			Path:   property.Path,
+		})
+	}
+	return entries
+}
+
+// AsProperty returns the trigger property as a Property.
+func (triggerProperty *TriggerProperty) AsProperty() Property {
+	return Property{
+		Name:   triggerProperty.Name,
+		Value:  triggerProperty.Value,
+		Href:   triggerProperty.Href,
+		Enum:   triggerProperty.Enum,
+		Type:   triggerProperty.Type,
+		Locked: triggerProperty.Locked,
+		Path:   triggerProperty.Path,
+	}
+}
+
+// AsTriggerProperty returns the property as a TriggerProperty.
+func (property *Property) AsTriggerProperty() TriggerProperty {
+	return TriggerProperty{
+		Name:   property.Name,
+		Value:  property.Value,
+		Href:   property.Href,
+		Enum:   property.Enum,
+		Type:   property.Type,
+		Path:   property.Path,
+		Locked: property.Locked,
+	}
+}
+
+// WriteProperties writes the properties to w in the specified format.
+// The dotenv format records the type, enum, locked and path attributes in a
+// "# @property" comment preceding each NAME=value line.
+func WriteProperties(w io.Writer, format string, properties []Property) (err error) {
+	entries := propertyEntries(properties)
+	switch format {
+	case PropertyFormatJSONConst:
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		err = encoder.Encode(&propertyFile{Properties: entries})
+	case PropertyFormatYAMLConst:
+		encoder := yaml.NewEncoder(w)
+		encoder.SetIndent(2)
+		err = encoder.Encode(&propertyFile{Properties: entries})
+		if err == nil {
+			err = encoder.Close()
+		}
+	case PropertyFormatDotenvConst:
+		err = writeDotenv(w, entries)
+	default:
+		return core.SDKErrorf(nil, fmt.Sprintf("unsupported property format '%s'", format), "unknown-property-format", common.GetComponentInfo())
+	}
+	if err != nil {
+		err = core.SDKErrorf(err, "", "property-write-error", common.GetComponentInfo())
+	}
+	return
+}
+
+// ReadProperties reads properties in the specified format from r.
+func ReadProperties(r io.Reader, format string) (properties []Property, err error) {
+	var file propertyFile
+	switch format {
+	case PropertyFormatJSONConst:
+		err = json.NewDecoder(r).Decode(&file)
+	case PropertyFormatYAMLConst:
+		err = yaml.NewDecoder(r).Decode(&file)
+		if err == io.EOF {
+			err = nil
+		}
+	case PropertyFormatDotenvConst:
+		file.Properties, err = readDotenv(r)
+	default:
+		return nil, core.SDKErrorf(nil, fmt.Sprintf("unsupported property format '%s'", format), "unknown-property-format", common.GetComponentInfo())
+	}
+	if err != nil {
+		return nil, core.SDKErrorf(err, "", "property-read-error", common.GetComponentInfo())
+	}
+
+	seen := map[string]bool{}
+	for i := range file.Properties {
+		entry := &file.Properties[i]
+		if entry.Name == "" {
+			return nil, core.SDKErrorf(nil, fmt.Sprintf("property %d has no name", i+1), "property-missing-name", common.GetComponentInfo())
+		}
+		if seen[entry.Name] {
+			return nil, core.SDKErrorf(nil, fmt.Sprintf("duplicate property '%s'", entry.Name), "property-duplicate-name", common.GetComponentInfo())
+		}
+		seen[entry.Name] = true
+		if entry.Type == "" {
+			entry.Type = PropertyTypeTextConst
+		}
+		properties = append(properties, entry.asProperty())
+	}
+	return
+}
+
+// WriteTriggerProperties writes the trigger properties to w in the specified format.
+func WriteTriggerProperties(w io.Writer, format string, triggerProperties []TriggerProperty) error {
+	properties := make([]Property, 0, len(triggerProperties))
+	for i := range triggerProperties {
+		properties = append(properties, triggerProperties[i].AsProperty())
+	}
+	return WriteProperties(w, format, properties)
+}
+
+// ReadTriggerProperties reads trigger properties in the specified format from r.
+func ReadTriggerProperties(r io.Reader, format string) (triggerProperties []TriggerProperty, err error) {
+	properties, err := ReadProperties(r, format)
+	if err != nil {
+		return
+	}
+	for i := range properties {
+		triggerProperties = append(triggerProperties, properties[i].AsTriggerProperty())
+	}
+	return
+}
+
+// ExportPropertiesFile writes the properties to the specified file, choosing the format from its extension.
+func ExportPropertiesFile(path string, properties []Property) (err error) {
+	format, err := PropertyFormatFromPath(path)
+	if err != nil {
+		return
+	}
+	var buffer bytes.Buffer
+	if err = WriteProperties(&buffer, format, properties); err != nil {
+		return
+	}
+	if err = os.WriteFile(path, buffer.Bytes(), 0600); err != nil {
+		err = core.SDKErrorf(err, "", "property-file-write-error", common.GetComponentInfo())
+	}
+	return
+}
+
+// ImportPropertiesFile reads properties from the specified file, choosing the format from its extension.
+func ImportPropertiesFile(path string) (properties []Property, err error) {
+	format, err := PropertyFormatFromPath(path)
+	if err != nil {
+		return
+	}
+	file, err := os.Open(path)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "property-file-read-error", common.GetComponentInfo())
+		return
+	}
+	defer file.Close()
+	return ReadProperties(file, format)
+}
+
+const dotenvAnnotation = "# @property"
+
+var dotenvNamePattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_.\-]*$`)
+
+func writeDotenv(w io.Writer, entries []propertyFileEntry) error {
+	buffered := bufio.NewWriter(w)
+	for _, entry := range entries {
+		if !dotenvNamePattern.MatchString(entry.Name) {
+			return fmt.Errorf("property name '%s' cannot be represented in dotenv format", entry.Name)
+		}
+		attributes := []string{"type=" + entry.Type}
+		if len(entry.Enum) > 0 {
+			attributes = append(attributes, "enum="+strconv.Quote(joinDotenvEnum(entry.Enum)))
+		}
+		if entry.Locked != nil {
+			attributes = append(attributes, "locked="+strconv.FormatBool(*entry.Locked))
+		}
+		if entry.Path != nil {
+			attributes = append(attributes, "path="+strconv.Quote(*entry.Path))
+		}
+		fmt.Fprintf(buffered, "%s %s\n", dotenvAnnotation, strings.Join(attributes, " "))
+		fmt.Fprintf(buffered, "%s=%s\n", entry.Name, quoteDotenvValue(core.StringNilMapper(entry.Value)))
+	}
+	return buffered.Flush()
+}
+
+func quoteDotenvValue(value string) string {
+	if value != "" && !strings.ContainsAny(value, " \t\r\n\"'#\\$") {
+		return value
+	}
+	return strconv.Quote(value)
+}
+
+func readDotenv(r io.Reader) (entries []propertyFileEntry, err error) {
+	scanner := bufio.NewScanner(r)
+	var pending *propertyFileEntry
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case strings.HasPrefix(line, dotenvAnnotation):
+			pending, err = parseDotenvAnnotation(strings.TrimPrefix(line, dotenvAnnotation))
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", lineNumber, err)
+			}
+		case line == "" || strings.HasPrefix(line, "#"):
+			continue
+		default:
+			line = strings.TrimPrefix(line, "export ")
+			name, value, found := strings.Cut(line, "=")
+			name = strings.TrimSpace(name)
+			if !found || !dotenvNamePattern.MatchString(name) {
+				return nil, fmt.Errorf("line %d: expected NAME=value", lineNumber)
+			}
+			value, err = unquoteDotenvValue(strings.TrimSpace(value))
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", lineNumber, err)
+			}
+			entry := propertyFileEntry{Type: PropertyTypeTextConst}
+			if pending != nil {
+				entry = *pending
+				pending = nil
+			}
+			entry.Name = name
+			entry.Value = core.StringPtr(value)
+			entries = append(entries, entry)
+		}
+	}
+	err = scanner.Err()
+	return
+}
+
+func parseDotenvAnnotation(text string) (entry *propertyFileEntry, err error) {
+	entry = &propertyFileEntry{Type: PropertyTypeTextConst}
+	for text = strings.TrimSpace(text); text != ""; text = strings.TrimSpace(text) {
+		key, rest, found := strings.Cut(text, "=")
+		if !found {
+			return nil, fmt.Errorf("malformed property annotation '%s'", text)
+		}
+		var value string
+		if strings.HasPrefix(rest, `"`) {
+			value, err = strconv.QuotedPrefix(rest)
+			if err != nil {
+				return nil, fmt.Errorf("malformed value for '%s': %w", key, err)
+			}
+			text = rest[len(value):]
+			value, _ = strconv.Unquote(value)
+		} else {
+			value, text, _ = strings.Cut(rest, " ")
+		}
+		switch key {
+		case "type":
+			entry.Type = value
+		case "enum":
+			entry.Enum = splitDotenvEnum(value)
+		case "locked":
+			locked, parseErr := strconv.ParseBool(value)
+			if parseErr != nil {
+				return nil, fmt.Errorf("malformed value for 'locked': %w", parseErr)
+			}
+			entry.Locked = core.BoolPtr(locked)
+		case "path":
+			entry.Path = core.StringPtr(value)
+		default:
+			return nil, fmt.Errorf("unknown property attribute '%s'", key)
+		}
+	}
+	return
+}
+
+// joinDotenvEnum joins the enum options with commas, escaping commas and backslashes within options.
+func joinDotenvEnum(options []string) string {
+	escaped := make([]string, len(options))
+	for i, option := range options {
+		escaped[i] = dotenvEnumEscaper.Replace(option)
+	}
+	return strings.Join(escaped, ",")
+}
+
+var dotenvEnumEscaper = strings.NewReplacer(`\`, `\\`, ",", `\,`)
+
+// splitDotenvEnum reverses joinDotenvEnum.
+func splitDotenvEnum(value string) (options []string) {
+	var option strings.Builder
+	for i := 0; i < len(value); i++ {
+		switch {
+		case value[i] == '\\' && i+1 < len(value):
+			i++
+			option.WriteByte(value[i])
+		case value[i] == ',':
+			options = append(options, option.String())
+			option.Reset()
+		default:
+			option.WriteByte(value[i])
+		}
+	}
+	return append(options, option.String())
+}
+
+func unquoteDotenvValue(value string) (string, error) {
+	switch {
+	case strings.HasPrefix(value, `"`):
+		return strconv.Unquote(value)
+	case strings.HasPrefix(value, "'"):
+		if len(value) < 2 || !strings.HasSuffix(value, "'") {
+			return "", fmt.Errorf("unterminated single-quoted value")
+		}
+		return value[1 : len(value)-1], nil
+	}
+	// Strip trailing comments from unquoted values.
+	if i := strings.Index(value, " #"); i >= 0 {
+		value = strings.TrimSpace(value[:i])
+	}
+	return value, nil
+}
@@ -0,0 +1,114 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtektonpipelinev2_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtektonpipelinev2"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Property files`, func() {
+	properties := []cdtektonpipelinev2.Property{
+		{Name: core.StringPtr("region"), Value: core.StringPtr("us-south"), Type: core.StringPtr("single_select"), Enum: []string{"us-south", "eu-de"}, Locked: core.BoolPtr(true)},
+		{Name: core.StringPtr("greeting"), Value: core.StringPtr(`hello "world" # not a comment`), Type: core.StringPtr("text")},
+		{Name: core.StringPtr("api-key"), Value: core.StringPtr(""), Type: core.StringPtr("secure"), Locked: core.BoolPtr(false)},
+		{Name: core.StringPtr("repo"), Value: core.StringPtr("0c9a4b2e-c5a8-4a55-9d7e-1e2fbc4b08f1"), Type: core.StringPtr("integration"), Path: core.StringPtr("parameters.repo_url")},
+	}
+
+	for _, format := range []string{cdtektonpipelinev2.PropertyFormatDotenvConst, cdtektonpipelinev2.PropertyFormatJSONConst, cdtektonpipelinev2.PropertyFormatYAMLConst} {
+		format := format
+		It(`Round trip properties in `+format+` format`, func() {
+			var buffer bytes.Buffer
+			Expect(cdtektonpipelinev2.WriteProperties(&buffer, format, properties)).To(Succeed())
+			result, err := cdtektonpipelinev2.ReadProperties(&buffer, format)
+			Expect(err).To(BeNil())
+			Expect(result).To(Equal(properties))
+		})
+		It(`Round trip trigger properties in `+format+` format`, func() {
+			triggerProperties := []cdtektonpipelinev2.TriggerProperty{properties[0].AsTriggerProperty(), properties[3].AsTriggerProperty()}
+			var buffer bytes.Buffer
+			Expect(cdtektonpipelinev2.WriteTriggerProperties(&buffer, format, triggerProperties)).To(Succeed())
+			result, err := cdtektonpipelinev2.ReadTriggerProperties(&buffer, format)
+			Expect(err).To(BeNil())
+			Expect(result).To(Equal(triggerProperties))
+		})
+	}
+
+	It(`Read a hand-written dotenv file`, func() {
+		input := strings.Join([]string{
+			"# Pipeline environment",
+			"export STAGE=dev",
+			"QUOTED='single quoted'",
+			"COMMENTED=value # trailing comment",
+			`# @property type=single_select enum="a,b" locked=true`,
+			"CHOICE=a",
+		}, "\n")
+		result, err := cdtektonpipelinev2.ReadProperties(strings.NewReader(input), cdtektonpipelinev2.PropertyFormatDotenvConst)
+		Expect(err).To(BeNil())
+		Expect(result).To(HaveLen(4))
+		Expect(*result[0].Name).To(Equal("STAGE"))
+		Expect(*result[0].Type).To(Equal("text"))
+		Expect(*result[1].Value).To(Equal("single quoted"))
+		Expect(*result[2].Value).To(Equal("value"))
+		Expect(result[3].Enum).To(Equal([]string{"a", "b"}))
+		Expect(*result[3].Locked).To(BeTrue())
+	})
+	It(`Round-trip enum options containing commas through dotenv`, func() {
+		properties := []cdtektonpipelinev2.Property{
+			{Name: core.StringPtr("REGION"), Value: core.StringPtr("a,b"), Type: core.StringPtr("single_select"), Enum: []string{"a,b", `c\d`, "e"}},
+		}
+		var buffer bytes.Buffer
+		Expect(cdtektonpipelinev2.WriteProperties(&buffer, cdtektonpipelinev2.PropertyFormatDotenvConst, properties)).To(Succeed())
+		result, err := cdtektonpipelinev2.ReadProperties(&buffer, cdtektonpipelinev2.PropertyFormatDotenvConst)
+		Expect(err).To(BeNil())
+		Expect(result).To(HaveLen(1))
+		Expect(result[0].Enum).To(Equal([]string{"a,b", `c\d`, "e"}))
+		Expect(*result[0].Value).To(Equal("a,b"))
+	})
+	It(`Reject invalid property files`, func() {
+		_, err := cdtektonpipelinev2.ReadProperties(strings.NewReader("A=1\nA=2\n"), cdtektonpipelinev2.PropertyFormatDotenvConst)
+		Expect(err).ToNot(BeNil())
+		_, err = cdtektonpipelinev2.ReadProperties(strings.NewReader("# @property colour=blue\nA=1\n"), cdtektonpipelinev2.PropertyFormatDotenvConst)
+		Expect(err).ToNot(BeNil())
+		_, err = cdtektonpipelinev2.ReadProperties(strings.NewReader(`{"properties":[{"type":"text"}]}`), cdtektonpipelinev2.PropertyFormatJSONConst)
+		Expect(err).ToNot(BeNil())
+		_, err = cdtektonpipelinev2.ReadProperties(strings.NewReader(""), "toml")
+		Expect(err).ToNot(BeNil())
+	})
+	It(`Export and import property files by extension`, func() {
+		dir, err := os.MkdirTemp("", "properties")
+		Expect(err).To(BeNil())
+		defer os.RemoveAll(dir)
+
+		for _, name := range []string{"pipeline.env", ".env", "pipeline.json", "pipeline.yml"} {
+			path := filepath.Join(dir, name)
+			Expect(cdtektonpipelinev2.ExportPropertiesFile(path, properties)).To(Succeed())
+			result, err := cdtektonpipelinev2.ImportPropertiesFile(path)
+			Expect(err).To(BeNil())
+			Expect(result).To(Equal(properties))
+		}
+		_, err = cdtektonpipelinev2.PropertyFormatFromPath("pipeline.txt")
+		Expect(err).ToNot(BeNil())
+	})
+})
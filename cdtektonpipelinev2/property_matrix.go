@@ -0,0 +1,268 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtektonpipelinev2
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	common "github.com/IBM/continuous-delivery-go-sdk/v2/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Constants associated with the PropertyMatrixCell.State property.
+const (
+	// The trigger does not define the property and receives the pipeline value.
+	PropertyMatrixCellStateInheritedConst = "inherited"
+	// The trigger defines a property that replaces the pipeline value.
+	PropertyMatrixCellStateOverriddenConst = "overridden"
+	// The pipeline property is locked, so the trigger always receives the pipeline value.
+	PropertyMatrixCellStateLockedConst = "locked"
+	// The property is only defined by the trigger.
+	PropertyMatrixCellStateTriggerOnlyConst = "trigger_only"
+	// Neither the pipeline nor the trigger define the property.
+	PropertyMatrixCellStateAbsentConst = "absent"
+)
+
+// PropertyMatrixTrigger : A trigger column of a PropertyMatrix.
+type PropertyMatrixTrigger struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// PropertyMatrixCell : The value a trigger receives for a property.
+type PropertyMatrixCell struct {
+	// One of the PropertyMatrixCellState constants.
+	State string `json:"state"`
+
+	// The effective value, masked for secure properties.
+	Value string `json:"value,omitempty"`
+
+	// The effective property type.
+	Type string `json:"type,omitempty"`
+
+	// True if the trigger property is locked against runtime overrides.
+	Locked bool `json:"locked,omitempty"`
+}
+
+// PropertyMatrixRow : A property row of a PropertyMatrix.
+type PropertyMatrixRow struct {
+	// Property name.
+	Name string `json:"name"`
+
+	// The pipeline property, or nil if the property is only defined by triggers.
+	Pipeline *PropertyMatrixCell `json:"pipeline,omitempty"`
+
+	// One cell per trigger, in the order of PropertyMatrix.Triggers.
+	Triggers []PropertyMatrixCell `json:"triggers"`
+}
+
+// PropertyMatrix : The property names of a pipeline and its triggers, showing for each trigger
+// whether the pipeline value is inherited, overridden or locked.
+type PropertyMatrix struct {
+	PipelineID string                  `json:"pipeline_id"`
+	Triggers   []PropertyMatrixTrigger `json:"triggers"`
+	Rows       []PropertyMatrixRow     `json:"rows"`
+}
+
+// GetPropertyMatrix invokes GetPropertyMatrixWithContext using context.Background().
+func (cdTektonPipeline *CdTektonPipelineV2) GetPropertyMatrix(pipelineID string) (matrix *PropertyMatrix, err error) {
+	matrix, err = cdTektonPipeline.GetPropertyMatrixWithContext(context.Background(), pipelineID)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// GetPropertyMatrixWithContext loads the pipeline's properties, its triggers and each trigger's
+// properties, and builds the resulting PropertyMatrix.
+func (cdTektonPipeline *CdTektonPipelineV2) GetPropertyMatrixWithContext(ctx context.Context, pipelineID string) (matrix *PropertyMatrix, err error) {
+	if pipelineID == "" {
+		err = core.SDKErrorf(nil, "pipelineID must be set", "missing-pipeline-id", common.GetComponentInfo())
+		return
+	}
+	properties, _, err := cdTektonPipeline.ListTektonPipelinePropertiesWithContext(ctx, cdTektonPipeline.NewListTektonPipelinePropertiesOptions(pipelineID))
+	if err != nil {
+		return
+	}
+	triggers, _, err := cdTektonPipeline.ListTektonPipelineTriggersWithContext(ctx, cdTektonPipeline.NewListTektonPipelineTriggersOptions(pipelineID))
+	if err != nil {
+		return
+	}
+
+	triggerProperties := map[string][]TriggerProperty{}
+	var columns []PropertyMatrixTrigger
+	for _, item := range triggers.Triggers {
+		trigger := AsTrigger(item)
+		if trigger == nil || trigger.ID == nil {
+			continue
+		}
+		var result *TriggerPropertiesCollection
+		result, _, err = cdTektonPipeline.ListTektonPipelineTriggerPropertiesWithContext(ctx, cdTektonPipeline.NewListTektonPipelineTriggerPropertiesOptions(pipelineID, *trigger.ID))
+		if err != nil {
+			return
+		}
+		triggerProperties[*trigger.ID] = result.Properties
+		columns = append(columns, PropertyMatrixTrigger{ID: *trigger.ID, Name: core.StringNilMapper(trigger.Name)})
+	}
+
+	matrix = NewPropertyMatrix(pipelineID, properties.Properties, columns, triggerProperties)
+	return
+}
+
+// NewPropertyMatrix builds a PropertyMatrix from already loaded pipeline and trigger properties.
+// triggerProperties is keyed by trigger ID.
+func NewPropertyMatrix(pipelineID string, properties []Property, triggers []PropertyMatrixTrigger, triggerProperties map[string][]TriggerProperty) *PropertyMatrix {
+	matrix := &PropertyMatrix{PipelineID: pipelineID, Triggers: triggers, Rows: []PropertyMatrixRow{}}
+
+	pipelineByName := map[string]*Property{}
+	names := map[string]bool{}
+	for i := range properties {
+		name := core.StringNilMapper(properties[i].Name)
+		pipelineByName[name] = &properties[i]
+		names[name] = true
+	}
+	triggerByName := make([]map[string]*TriggerProperty, len(triggers))
+	for i, trigger := range triggers {
+		triggerByName[i] = map[string]*TriggerProperty{}
+		list := triggerProperties[trigger.ID]
+		for j := range list {
+			name := core.StringNilMapper(list[j].Name)
+			triggerByName[i][name] = &list[j]
+			names[name] = true
+		}
+	}
+
+	sortedNames := make([]string, 0, len(names))
+	for name := range names {
+		sortedNames = append(sortedNames, name)
+	}
+	sort.Strings(sortedNames)
+
+	for _, name := range sortedNames {
+		row := PropertyMatrixRow{Name: name, Triggers: make([]PropertyMatrixCell, len(triggers))}
+		pipelineProperty := pipelineByName[name]
+		if pipelineProperty != nil {
+			row.Pipeline = &PropertyMatrixCell{
+				State:  PropertyMatrixCellStateInheritedConst,
+				Value:  displayValue(pipelineProperty.Type, pipelineProperty.Value),
+				Type:   core.StringNilMapper(pipelineProperty.Type),
+				Locked: pipelineProperty.Locked != nil && *pipelineProperty.Locked,
+			}
+		}
+		for i := range triggers {
+			row.Triggers[i] = propertyMatrixCell(row.Pipeline, triggerByName[i][name])
+		}
+		matrix.Rows = append(matrix.Rows, row)
+	}
+	return matrix
+}
+
+func propertyMatrixCell(pipeline *PropertyMatrixCell, triggerProperty *TriggerProperty) PropertyMatrixCell {
+	switch {
+	case pipeline != nil && pipeline.Locked:
+		return PropertyMatrixCell{State: PropertyMatrixCellStateLockedConst, Value: pipeline.Value, Type: pipeline.Type, Locked: true}
+	case triggerProperty == nil && pipeline == nil:
+		return PropertyMatrixCell{State: PropertyMatrixCellStateAbsentConst}
+	case triggerProperty == nil:
+		return PropertyMatrixCell{State: PropertyMatrixCellStateInheritedConst, Value: pipeline.Value, Type: pipeline.Type}
+	}
+	cell := PropertyMatrixCell{
+		State:  PropertyMatrixCellStateOverriddenConst,
+		Value:  displayValue(triggerProperty.Type, triggerProperty.Value),
+		Type:   core.StringNilMapper(triggerProperty.Type),
+		Locked: triggerProperty.Locked != nil && *triggerProperty.Locked,
+	}
+	if pipeline == nil {
+		cell.State = PropertyMatrixCellStateTriggerOnlyConst
+	}
+	return cell
+}
+
+func displayValue(propertyType *string, value *string) string {
+	if core.StringNilMapper(propertyType) == PropertyTypeSecureConst && core.StringNilMapper(value) != "" {
+		return common.RedactedValue
+	}
+	return core.StringNilMapper(value)
+}
+
+func (cell *PropertyMatrixCell) String() string {
+	switch cell.State {
+	case PropertyMatrixCellStateAbsentConst:
+		return "-"
+	case PropertyMatrixCellStateInheritedConst:
+		return "^ " + cell.Value
+	}
+	return fmt.Sprintf("%s [%s]", cell.Value, cell.State)
+}
+
+// WriteText renders the matrix as an aligned text table. Inherited values are prefixed with "^".
+func (matrix *PropertyMatrix) WriteText(w io.Writer) error {
+	table := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	header := []string{"PROPERTY", "PIPELINE"}
+	for _, trigger := range matrix.Triggers {
+		header = append(header, strings.ToUpper(trigger.Name))
+	}
+	fmt.Fprintln(table, strings.Join(header, "\t"))
+	for _, row := range matrix.Rows {
+		line := []string{row.Name, "-"}
+		if row.Pipeline != nil {
+			line[1] = row.Pipeline.Value
+			if row.Pipeline.Locked {
+				line[1] += " [locked]"
+			}
+		}
+		for i := range row.Triggers {
+			line = append(line, row.Triggers[i].String())
+		}
+		fmt.Fprintln(table, strings.Join(line, "\t"))
+	}
+	return table.Flush()
+}
+
+// WriteCSV renders the matrix as CSV, with one "state" and one "value" column per trigger.
+func (matrix *PropertyMatrix) WriteCSV(w io.Writer) error {
+	writer := csv.NewWriter(w)
+	header := []string{"property", "pipeline_value", "pipeline_locked"}
+	for _, trigger := range matrix.Triggers {
+		header = append(header, trigger.Name+" state", trigger.Name+" value")
+	}
+	records := [][]string{header}
+	for _, row := range matrix.Rows {
+		record := []string{row.Name, "", ""}
+		if row.Pipeline != nil {
+			record[1] = row.Pipeline.Value
+			record[2] = fmt.Sprint(row.Pipeline.Locked)
+		}
+		for _, cell := range row.Triggers {
+			record = append(record, cell.State, cell.Value)
+		}
+		records = append(records, record)
+	}
+	return writer.WriteAll(records)
+}
+
+// WriteJSON renders the matrix as indented JSON.
+func (matrix *PropertyMatrix) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(matrix)
+}
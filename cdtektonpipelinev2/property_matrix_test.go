@@ -0,0 +1,122 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtektonpipelinev2_test
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtektonpipelinev2"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`PropertyMatrix`, func() {
+	var testServer *httptest.Server
+	basePath := "/tekton_pipelines/94619026-912b-4d92-8f51-6c74f0692d90"
+
+	BeforeEach(func() {
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+			Expect(req.Method).To(Equal("GET"))
+			res.Header().Set("Content-type", "application/json")
+			switch req.URL.EscapedPath() {
+			case basePath + "/properties":
+				fmt.Fprint(res, `{"properties": [
+					{"name": "region", "type": "text", "value": "us-south"},
+					{"name": "env", "type": "text", "value": "dev", "locked": true},
+					{"name": "token", "type": "secure", "value": "secret-hash"}]}`)
+			case basePath + "/triggers":
+				fmt.Fprint(res, `{"triggers": [
+					{"id": "t1", "name": "manual", "type": "manual"},
+					{"id": "t2", "name": "git", "type": "scm"}]}`)
+			case basePath + "/triggers/t1/properties":
+				fmt.Fprint(res, `{"properties": [{"name": "region", "type": "text", "value": "eu-de", "locked": true}]}`)
+			case basePath + "/triggers/t2/properties":
+				fmt.Fprint(res, `{"properties": [{"name": "branch", "type": "text", "value": "main"}, {"name": "env", "type": "text", "value": "prod"}]}`)
+			default:
+				res.WriteHeader(404)
+			}
+		}))
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	getMatrix := func() *cdtektonpipelinev2.PropertyMatrix {
+		cdTektonPipelineService, err := cdtektonpipelinev2.NewCdTektonPipelineV2(&cdtektonpipelinev2.CdTektonPipelineV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+		matrix, err := cdTektonPipelineService.GetPropertyMatrix("94619026-912b-4d92-8f51-6c74f0692d90")
+		Expect(err).To(BeNil())
+		return matrix
+	}
+
+	It(`Invoke GetPropertyMatrix successfully`, func() {
+		matrix := getMatrix()
+		Expect(matrix.Triggers).To(Equal([]cdtektonpipelinev2.PropertyMatrixTrigger{{ID: "t1", Name: "manual"}, {ID: "t2", Name: "git"}}))
+		Expect(matrix.Rows).To(HaveLen(4))
+
+		byName := map[string]cdtektonpipelinev2.PropertyMatrixRow{}
+		for _, row := range matrix.Rows {
+			byName[row.Name] = row
+		}
+		Expect(byName["branch"].Pipeline).To(BeNil())
+		Expect(byName["branch"].Triggers[0].State).To(Equal(cdtektonpipelinev2.PropertyMatrixCellStateAbsentConst))
+		Expect(byName["branch"].Triggers[1].State).To(Equal(cdtektonpipelinev2.PropertyMatrixCellStateTriggerOnlyConst))
+		Expect(byName["env"].Triggers[1].State).To(Equal(cdtektonpipelinev2.PropertyMatrixCellStateLockedConst))
+		Expect(byName["env"].Triggers[1].Value).To(Equal("dev"))
+		Expect(byName["region"].Triggers[0].State).To(Equal(cdtektonpipelinev2.PropertyMatrixCellStateOverriddenConst))
+		Expect(byName["region"].Triggers[0].Locked).To(BeTrue())
+		Expect(byName["region"].Triggers[1].State).To(Equal(cdtektonpipelinev2.PropertyMatrixCellStateInheritedConst))
+		Expect(byName["token"].Pipeline.Value).To(Equal("********"))
+		Expect(byName["token"].Triggers[0].Value).To(Equal("********"))
+	})
+	It(`Render the matrix as text, CSV and JSON`, func() {
+		matrix := getMatrix()
+
+		var text bytes.Buffer
+		Expect(matrix.WriteText(&text)).To(Succeed())
+		lines := strings.Split(strings.TrimSpace(text.String()), "\n")
+		Expect(lines).To(HaveLen(5))
+		Expect(strings.Fields(lines[0])).To(Equal([]string{"PROPERTY", "PIPELINE", "MANUAL", "GIT"}))
+		Expect(lines[3]).To(ContainSubstring("eu-de [overridden]"))
+		Expect(lines[3]).To(ContainSubstring("^ us-south"))
+		Expect(text.String()).ToNot(ContainSubstring("secret-hash"))
+
+		var csvOutput bytes.Buffer
+		Expect(matrix.WriteCSV(&csvOutput)).To(Succeed())
+		records, err := csv.NewReader(&csvOutput).ReadAll()
+		Expect(err).To(BeNil())
+		Expect(records[0]).To(Equal([]string{"property", "pipeline_value", "pipeline_locked", "manual state", "manual value", "git state", "git value"}))
+		Expect(records[2]).To(Equal([]string{"env", "dev", "true", "locked", "dev", "locked", "dev"}))
+
+		var jsonOutput bytes.Buffer
+		Expect(matrix.WriteJSON(&jsonOutput)).To(Succeed())
+		var decoded cdtektonpipelinev2.PropertyMatrix
+		Expect(json.Unmarshal(jsonOutput.Bytes(), &decoded)).To(Succeed())
+		Expect(&decoded).To(Equal(matrix))
+	})
+})
@@ -0,0 +1,245 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtektonpipelinev2
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+
+	common "github.com/IBM/continuous-delivery-go-sdk/v2/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// SyncTektonPipelinePropertiesOptions : The SyncTektonPipelineProperties options.
+type SyncTektonPipelinePropertiesOptions struct {
+	// The Tekton pipeline ID.
+	PipelineID string
+
+	// The trigger ID. When set, the trigger's properties are synchronized instead of the pipeline's.
+	TriggerID string
+
+	// The desired set of properties, typically obtained from ImportPropertiesFile.
+	Properties []Property
+
+	// When true, live properties that are not in Properties are deleted.
+	Prune bool
+
+	// When true, the changes are computed and reported but not applied.
+	DryRun bool
+
+	// Optional resolvers for `secure` property values that are secret references, such as
+	// `vault:secret/data/app#api_key`. References are resolved just before a property is created
+	// or replaced, and the resolved values are not kept.
+	SecretResolvers SecretResolvers
+}
+
+// PropertySyncResult : The changes made, or that would be made in dry-run mode, by a property sync.
+type PropertySyncResult struct {
+	Created   []string `json:"created"`
+	Replaced  []string `json:"replaced"`
+	Deleted   []string `json:"deleted"`
+	Unchanged []string `json:"unchanged"`
+}
+
+// SyncTektonPipelineProperties invokes SyncTektonPipelinePropertiesWithContext using context.Background().
+func (cdTektonPipeline *CdTektonPipelineV2) SyncTektonPipelineProperties(options *SyncTektonPipelinePropertiesOptions) (result *PropertySyncResult, err error) {
+	result, err = cdTektonPipeline.SyncTektonPipelinePropertiesWithContext(context.Background(), options)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// SyncTektonPipelinePropertiesWithContext makes the live pipeline (or trigger) properties match
+// options.Properties, creating missing properties, replacing those that differ and, if
+// options.Prune is set, deleting those that are not listed.
+//
+// Secure property values cannot be read back from the service, so a secure property that
+// specifies a value is always replaced.
+func (cdTektonPipeline *CdTektonPipelineV2) SyncTektonPipelinePropertiesWithContext(ctx context.Context, options *SyncTektonPipelinePropertiesOptions) (result *PropertySyncResult, err error) {
+	err = core.ValidateNotNil(options, "options cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	if options.PipelineID == "" {
+		err = core.SDKErrorf(nil, "the 'options.PipelineID' field must be set", "missing-pipeline-id", common.GetComponentInfo())
+		return
+	}
+
+	// Check every desired property before changing anything, so that a bad entry cannot
+	// leave the pipeline partially synchronized.
+	desiredNames := map[string]bool{}
+	for i := range options.Properties {
+		desired := &options.Properties[i]
+		if desired.Name == nil || desired.Type == nil {
+			err = core.SDKErrorf(nil, fmt.Sprintf("property %d must specify a name and a type", i+1), "property-missing-field", common.GetComponentInfo())
+			return
+		}
+		if desiredNames[*desired.Name] {
+			err = core.SDKErrorf(nil, fmt.Sprintf("duplicate property '%s'", *desired.Name), "property-duplicate-name", common.GetComponentInfo())
+			return
+		}
+		desiredNames[*desired.Name] = true
+	}
+
+	store := &propertyStore{client: cdTektonPipeline, pipelineID: options.PipelineID, triggerID: options.TriggerID}
+	live, err := store.list(ctx)
+	if err != nil {
+		return
+	}
+	liveByName := map[string]*Property{}
+	for i := range live {
+		liveByName[core.StringNilMapper(live[i].Name)] = &live[i]
+	}
+
+	result = &PropertySyncResult{}
+	for i := range options.Properties {
+		desired := &options.Properties[i]
+		name := *desired.Name
+		current, exists := liveByName[name]
+		switch {
+		case !exists:
+			if !options.DryRun {
+				if err = store.create(ctx, options.SecretResolvers, desired); err != nil {
+					return
+				}
+			}
+			result.Created = append(result.Created, name)
+		case !propertiesEquivalent(current, desired):
+			if !options.DryRun {
+				if err = store.replace(ctx, options.SecretResolvers, desired); err != nil {
+					return
+				}
+			}
+			result.Replaced = append(result.Replaced, name)
+		default:
+			result.Unchanged = append(result.Unchanged, name)
+		}
+	}
+
+	if options.Prune {
+		for name := range liveByName {
+			if desiredNames[name] {
+				continue
+			}
+			if !options.DryRun {
+				if err = store.delete(ctx, name); err != nil {
+					return
+				}
+			}
+			result.Deleted = append(result.Deleted, name)
+		}
+		sort.Strings(result.Deleted)
+	}
+	return
+}
+
+// propertiesEquivalent returns true if applying desired over current would not change anything.
+func propertiesEquivalent(current *Property, desired *Property) bool {
+	if core.StringNilMapper(current.Type) != core.StringNilMapper(desired.Type) ||
+		!slices.Equal(current.Enum, desired.Enum) ||
+		core.StringNilMapper(current.Path) != core.StringNilMapper(desired.Path) ||
+		(current.Locked != nil && *current.Locked) != (desired.Locked != nil && *desired.Locked) {
+		return false
+	}
+	if core.StringNilMapper(desired.Type) == PropertyTypeSecureConst {
+		return desired.Value == nil
+	}
+	return core.StringNilMapper(current.Value) == core.StringNilMapper(desired.Value)
+}
+
+// propertyStore hides the differences between the pipeline and trigger property operations.
+type propertyStore struct {
+	client     *CdTektonPipelineV2
+	pipelineID string
+	triggerID  string
+}
+
+func (store *propertyStore) list(ctx context.Context) (properties []Property, err error) {
+	if store.triggerID == "" {
+		var result *PropertiesCollection
+		result, _, err = store.client.ListTektonPipelinePropertiesWithContext(ctx, store.client.NewListTektonPipelinePropertiesOptions(store.pipelineID))
+		if err == nil {
+			properties = result.Properties
+		}
+		return
+	}
+	var result *TriggerPropertiesCollection
+	result, _, err = store.client.ListTektonPipelineTriggerPropertiesWithContext(ctx, store.client.NewListTektonPipelineTriggerPropertiesOptions(store.pipelineID, store.triggerID))
+	if err == nil {
+		for i := range result.Properties {
+			properties = append(properties, result.Properties[i].AsProperty())
+		}
+	}
+	return
+}
+
+func (store *propertyStore) create(ctx context.Context, resolvers SecretResolvers, property *Property) (err error) {
+	property, err = resolvers.resolveProperty(ctx, property)
+	if err != nil {
+		return
+	}
+	if store.triggerID == "" {
+		options := store.client.NewCreateTektonPipelinePropertiesOptions(store.pipelineID, *property.Name, *property.Type)
+		options.Value = property.Value
+		options.Enum = property.Enum
+		options.Locked = property.Locked
+		options.Path = property.Path
+		_, _, err = store.client.CreateTektonPipelinePropertiesWithContext(ctx, options)
+		return
+	}
+	options := store.client.NewCreateTektonPipelineTriggerPropertiesOptions(store.pipelineID, store.triggerID, *property.Name, *property.Type)
+	options.Value = property.Value
+	options.Enum = property.Enum
+	options.Locked = property.Locked
+	options.Path = property.Path
+	_, _, err = store.client.CreateTektonPipelineTriggerPropertiesWithContext(ctx, options)
+	return
+}
+
+func (store *propertyStore) replace(ctx context.Context, resolvers SecretResolvers, property *Property) (err error) {
+	property, err = resolvers.resolveProperty(ctx, property)
+	if err != nil {
+		return
+	}
+	if store.triggerID == "" {
+		options := store.client.NewReplaceTektonPipelinePropertyOptions(store.pipelineID, *property.Name, *property.Name, *property.Type)
+		options.Value = property.Value
+		options.Enum = property.Enum
+		options.Locked = property.Locked
+		options.Path = property.Path
+		_, _, err = store.client.ReplaceTektonPipelinePropertyWithContext(ctx, options)
+		return
+	}
+	options := store.client.NewReplaceTektonPipelineTriggerPropertyOptions(store.pipelineID, store.triggerID, *property.Name, *property.Name, *property.Type)
+	options.Value = property.Value
+	options.Enum = property.Enum
+	options.Locked = property.Locked
+	options.Path = property.Path
+	_, _, err = store.client.ReplaceTektonPipelineTriggerPropertyWithContext(ctx, options)
+	return
+}
+
+func (store *propertyStore) delete(ctx context.Context, name string) (err error) {
+	if store.triggerID == "" {
+		_, err = store.client.DeleteTektonPipelinePropertyWithContext(ctx, store.client.NewDeleteTektonPipelinePropertyOptions(store.pipelineID, name))
+		return
+	}
+	_, err = store.client.DeleteTektonPipelineTriggerPropertyWithContext(ctx, store.client.NewDeleteTektonPipelineTriggerPropertyOptions(store.pipelineID, store.triggerID, name))
+	return
+}
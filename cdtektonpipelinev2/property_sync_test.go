@@ -0,0 +1,150 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtektonpipelinev2_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtektonpipelinev2"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`SyncTektonPipelineProperties`, func() {
+	var (
+		testServer              *httptest.Server
+		cdTektonPipelineService *cdtektonpipelinev2.CdTektonPipelineV2
+		mutex                   sync.Mutex
+		live                    map[string]map[string]interface{}
+		calls                   []string
+	)
+	basePath := "/tekton_pipelines/94619026-912b-4d92-8f51-6c74f0692d90"
+
+	BeforeEach(func() {
+		live = map[string]map[string]interface{}{
+			"keep":   {"name": "keep", "type": "text", "value": "same"},
+			"change": {"name": "change", "type": "text", "value": "old"},
+			"extra":  {"name": "extra", "type": "text", "value": "x"},
+			"token":  {"name": "token", "type": "secure", "value": "hash"},
+		}
+		calls = nil
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+			mutex.Lock()
+			defer mutex.Unlock()
+
+			path := req.URL.EscapedPath()
+			res.Header().Set("Content-type", "application/json")
+			if req.Method == "GET" {
+				Expect(path).To(Or(Equal(basePath+"/properties"), Equal(basePath+"/triggers/t1/properties")))
+				list := []map[string]interface{}{}
+				for _, property := range live {
+					list = append(list, property)
+				}
+				_ = json.NewEncoder(res).Encode(map[string]interface{}{"properties": list})
+				return
+			}
+			calls = append(calls, req.Method+" "+strings.TrimPrefix(path, basePath))
+			var body map[string]interface{}
+			if req.Method != "DELETE" {
+				Expect(json.NewDecoder(req.Body).Decode(&body)).To(Succeed())
+				live[body["name"].(string)] = body
+				res.WriteHeader(201)
+				_ = json.NewEncoder(res).Encode(body)
+				return
+			}
+			delete(live, path[strings.LastIndex(path, "/")+1:])
+			res.WriteHeader(204)
+		}))
+		var err error
+		cdTektonPipelineService, err = cdtektonpipelinev2.NewCdTektonPipelineV2(&cdtektonpipelinev2.CdTektonPipelineV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	desired := []cdtektonpipelinev2.Property{
+		{Name: core.StringPtr("keep"), Type: core.StringPtr("text"), Value: core.StringPtr("same")},
+		{Name: core.StringPtr("change"), Type: core.StringPtr("text"), Value: core.StringPtr("new")},
+		{Name: core.StringPtr("token"), Type: core.StringPtr("secure")},
+		{Name: core.StringPtr("added"), Type: core.StringPtr("single_select"), Value: core.StringPtr("a"), Enum: []string{"a", "b"}},
+	}
+
+	It(`Invoke SyncTektonPipelineProperties in dry-run mode`, func() {
+		result, err := cdTektonPipelineService.SyncTektonPipelineProperties(&cdtektonpipelinev2.SyncTektonPipelinePropertiesOptions{
+			PipelineID: "94619026-912b-4d92-8f51-6c74f0692d90",
+			Properties: desired,
+			Prune:      true,
+			DryRun:     true,
+		})
+		Expect(err).To(BeNil())
+		Expect(result.Created).To(Equal([]string{"added"}))
+		Expect(result.Replaced).To(Equal([]string{"change"}))
+		Expect(result.Deleted).To(Equal([]string{"extra"}))
+		Expect(result.Unchanged).To(Equal([]string{"keep", "token"}))
+		Expect(calls).To(BeEmpty())
+	})
+	It(`Invoke SyncTektonPipelineProperties on pipeline properties`, func() {
+		result, err := cdTektonPipelineService.SyncTektonPipelineProperties(&cdtektonpipelinev2.SyncTektonPipelinePropertiesOptions{
+			PipelineID: "94619026-912b-4d92-8f51-6c74f0692d90",
+			Properties: desired,
+			Prune:      true,
+		})
+		Expect(err).To(BeNil())
+		Expect(result.Deleted).To(Equal([]string{"extra"}))
+		sort.Strings(calls)
+		Expect(calls).To(Equal([]string{"DELETE /properties/extra", "POST /properties", "PUT /properties/change"}))
+		Expect(live["change"]["value"]).To(Equal("new"))
+		Expect(live["added"]["enum"]).To(ConsistOf("a", "b"))
+	})
+	It(`Invoke SyncTektonPipelineProperties on trigger properties without pruning`, func() {
+		result, err := cdTektonPipelineService.SyncTektonPipelineProperties(&cdtektonpipelinev2.SyncTektonPipelinePropertiesOptions{
+			PipelineID: "94619026-912b-4d92-8f51-6c74f0692d90",
+			TriggerID:  "t1",
+			Properties: desired,
+		})
+		Expect(err).To(BeNil())
+		Expect(result.Deleted).To(BeEmpty())
+		sort.Strings(calls)
+		Expect(calls).To(Equal([]string{"POST /triggers/t1/properties", "PUT /triggers/t1/properties/change"}))
+		Expect(live).To(HaveKey("extra"))
+	})
+	It(`Invoke SyncTektonPipelineProperties with invalid options`, func() {
+		_, err := cdTektonPipelineService.SyncTektonPipelineProperties(nil)
+		Expect(err).ToNot(BeNil())
+		_, err = cdTektonPipelineService.SyncTektonPipelineProperties(&cdtektonpipelinev2.SyncTektonPipelinePropertiesOptions{})
+		Expect(err).ToNot(BeNil())
+
+		_, err = cdTektonPipelineService.SyncTektonPipelineProperties(&cdtektonpipelinev2.SyncTektonPipelinePropertiesOptions{
+			PipelineID: "94619026-912b-4d92-8f51-6c74f0692d90",
+			Properties: append(desired, cdtektonpipelinev2.Property{Name: core.StringPtr("untyped")}),
+		})
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("property 5"))
+		Expect(calls).To(BeEmpty())
+	})
+})
@@ -0,0 +1,156 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtektonpipelinev2
+
+import (
+	"encoding/json"
+	"fmt"
+
+	common "github.com/IBM/continuous-delivery-go-sdk/v2/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// The models below hold secret values. Their String and Format methods print a redacted JSON
+// representation, so that logging a model, or a slice of models, never reveals the secret.
+// Use the Redacted methods to obtain a copy that is safe to serialize. Request bodies are
+// built from the original values and are not affected.
+
+// Redacted returns a copy of the property in which the value of a `secure` property is masked.
+func (property Property) Redacted() Property {
+	if core.StringNilMapper(property.Type) == PropertyTypeSecureConst && core.StringNilMapper(property.Value) != "" {
+		property.Value = core.StringPtr(common.RedactedValue)
+	}
+	return property
+}
+
+// String returns a JSON representation of the redacted property.
+func (property Property) String() string {
+	type plain Property
+	return redactedString(plain(property.Redacted()))
+}
+
+// Format implements fmt.Formatter so that every verb prints the redacted property.
+func (property Property) Format(state fmt.State, verb rune) {
+	formatRedacted(state, verb, property.String(), fmt.Sprintf("%T", property))
+}
+
+// Redacted returns a copy of the trigger property in which the value of a `secure` property is masked.
+func (triggerProperty TriggerProperty) Redacted() TriggerProperty {
+	if core.StringNilMapper(triggerProperty.Type) == TriggerPropertyTypeSecureConst && core.StringNilMapper(triggerProperty.Value) != "" {
+		triggerProperty.Value = core.StringPtr(common.RedactedValue)
+	}
+	return triggerProperty
+}
+
+// String returns a JSON representation of the redacted trigger property.
+func (triggerProperty TriggerProperty) String() string {
+	type plain TriggerProperty
+	return redactedString(plain(triggerProperty.Redacted()))
+}
+
+// Format implements fmt.Formatter so that every verb prints the redacted trigger property.
+func (triggerProperty TriggerProperty) Format(state fmt.State, verb rune) {
+	formatRedacted(state, verb, triggerProperty.String(), fmt.Sprintf("%T", triggerProperty))
+}
+
+// Redacted returns a copy of the secret in which the value is masked.
+func (genericSecret GenericSecret) Redacted() GenericSecret {
+	if core.StringNilMapper(genericSecret.Value) != "" {
+		genericSecret.Value = core.StringPtr(common.RedactedValue)
+	}
+	return genericSecret
+}
+
+// String returns a JSON representation of the redacted secret.
+func (genericSecret GenericSecret) String() string {
+	type plain GenericSecret
+	return redactedString(plain(genericSecret.Redacted()))
+}
+
+// Format implements fmt.Formatter so that every verb prints the redacted secret.
+func (genericSecret GenericSecret) Format(state fmt.State, verb rune) {
+	formatRedacted(state, verb, genericSecret.String(), fmt.Sprintf("%T", genericSecret))
+}
+
+// Redacted returns a copy of the options in which every secure trigger property value is masked.
+func (options CreateTektonPipelineRunOptions) Redacted() CreateTektonPipelineRunOptions {
+	if options.SecureTriggerProperties != nil {
+		masked := make(map[string]interface{}, len(options.SecureTriggerProperties))
+		for name := range options.SecureTriggerProperties {
+			masked[name] = common.RedactedValue
+		}
+		options.SecureTriggerProperties = masked
+	}
+	if options.Headers != nil {
+		headers := make(map[string]string, len(options.Headers))
+		for name, value := range options.Headers {
+			if common.IsSensitiveKey(name) || name == "Authorization" {
+				value = common.RedactedValue
+			}
+			headers[name] = value
+		}
+		options.Headers = headers
+	}
+	return options
+}
+
+// String returns a JSON representation of the redacted options.
+func (options CreateTektonPipelineRunOptions) String() string {
+	type plain CreateTektonPipelineRunOptions
+	return redactedString(plain(options.Redacted()))
+}
+
+// Format implements fmt.Formatter so that every verb prints the redacted options.
+func (options CreateTektonPipelineRunOptions) Format(state fmt.State, verb rune) {
+	formatRedacted(state, verb, options.String(), fmt.Sprintf("%T", options))
+}
+
+// redactedString marshals an already redacted model, which must not implement fmt.Stringer.
+func redactedString(model interface{}) string {
+	b, err := json.Marshal(model)
+	if err != nil {
+		return fmt.Sprintf("%T(%s)", model, err.Error())
+	}
+	return string(b)
+}
+
+// formatRedacted prints the string representation of a redacted model, prefixed with the
+// model's type name for %#v.
+func formatRedacted(state fmt.State, verb rune, str string, typeName string) {
+	if verb == 'v' && state.Flag('#') {
+		fmt.Fprintf(state, "%s%s", typeName, str)
+		return
+	}
+	switch verb {
+	case 'q':
+		fmt.Fprintf(state, "%q", str)
+	default:
+		fmt.Fprint(state, str)
+	}
+}
+
+// EnableDebugLogging logs every request and response of this service instance at debug level,
+// with secure property values, secrets and credentials redacted from headers and bodies.
+// If logger is nil, the core library's logger is used.
+func (cdTektonPipeline *CdTektonPipelineV2) EnableDebugLogging(logger core.Logger) {
+	common.EnableDebugLogging(cdTektonPipeline.Service, logger)
+}
+
+// DisableDebugLogging stops the logging enabled by EnableDebugLogging.
+func (cdTektonPipeline *CdTektonPipelineV2) DisableDebugLogging() {
+	common.DisableDebugLogging(cdTektonPipeline.Service)
+}
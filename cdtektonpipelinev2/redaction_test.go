@@ -0,0 +1,123 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtektonpipelinev2_test
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtektonpipelinev2"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Redaction`, func() {
+	secure := cdtektonpipelinev2.Property{
+		Name:  core.StringPtr("token"),
+		Type:  core.StringPtr(cdtektonpipelinev2.PropertyTypeSecureConst),
+		Value: core.StringPtr("top-secret"),
+	}
+	text := cdtektonpipelinev2.Property{
+		Name:  core.StringPtr("region"),
+		Type:  core.StringPtr(cdtektonpipelinev2.PropertyTypeTextConst),
+		Value: core.StringPtr("us-south"),
+	}
+
+	It(`Masks secure properties in every format`, func() {
+		Expect(*secure.Redacted().Value).To(Equal("********"))
+		Expect(*secure.Value).To(Equal("top-secret"))
+		Expect(*text.Redacted().Value).To(Equal("us-south"))
+		for _, verb := range []string{"%v", "%+v", "%#v", "%s", "%q"} {
+			Expect(fmt.Sprintf(verb, secure)).ToNot(ContainSubstring("top-secret"), verb)
+			Expect(fmt.Sprintf(verb, &secure)).ToNot(ContainSubstring("top-secret"), verb)
+			Expect(fmt.Sprintf(verb, []cdtektonpipelinev2.Property{text, secure})).ToNot(ContainSubstring("top-secret"), verb)
+		}
+		Expect(fmt.Sprint(text)).To(ContainSubstring("us-south"))
+		Expect(fmt.Sprintf("%#v", secure)).To(ContainSubstring("Property{"))
+	})
+	It(`Masks secure trigger properties and secrets`, func() {
+		triggerProperty := cdtektonpipelinev2.TriggerProperty{
+			Name:  core.StringPtr("token"),
+			Type:  core.StringPtr(cdtektonpipelinev2.TriggerPropertyTypeSecureConst),
+			Value: core.StringPtr("top-secret"),
+		}
+		Expect(fmt.Sprintf("%+v", triggerProperty)).ToNot(ContainSubstring("top-secret"))
+		genericSecret := cdtektonpipelinev2.GenericSecret{Value: core.StringPtr("top-secret")}
+		Expect(fmt.Sprint(genericSecret)).ToNot(ContainSubstring("top-secret"))
+		Expect(fmt.Sprint(&cdtektonpipelinev2.Trigger{Properties: []cdtektonpipelinev2.TriggerProperty{triggerProperty}})).ToNot(ContainSubstring("top-secret"))
+	})
+	It(`Masks secure trigger properties of run options`, func() {
+		options := cdtektonpipelinev2.CreateTektonPipelineRunOptions{
+			PipelineID:              core.StringPtr("p1"),
+			SecureTriggerProperties: map[string]interface{}{"password": "top-secret"},
+			Headers:                 map[string]string{"Authorization": "Bearer top-secret", "X-Test": "visible"},
+		}
+		Expect(fmt.Sprintf("%v", options)).ToNot(ContainSubstring("top-secret"))
+		Expect(fmt.Sprintf("%#v", &options)).ToNot(ContainSubstring("top-secret"))
+		Expect(options.String()).To(ContainSubstring("visible"))
+		Expect(options.SecureTriggerProperties["password"]).To(Equal("top-secret"))
+	})
+	It(`Redacts secure values from debug logs`, func() {
+		testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			res.Header().Set("Content-type", "application/json")
+			res.WriteHeader(201)
+			fmt.Fprint(res, `{"name": "token", "type": "secure", "value": "response-secret"}`)
+		}))
+		defer testServer.Close()
+
+		var buf bytes.Buffer
+		logger := core.NewLogger(core.LevelDebug, log.New(&buf, "", 0), log.New(&buf, "", 0))
+		service, err := cdtektonpipelinev2.NewCdTektonPipelineV2(&cdtektonpipelinev2.CdTektonPipelineV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+		service.EnableDebugLogging(logger)
+
+		options := service.NewCreateTektonPipelinePropertiesOptions("p1", "token", "secure")
+		options.SetValue("request-secret")
+		property, _, err := service.CreateTektonPipelineProperties(options)
+		Expect(err).To(BeNil())
+		Expect(*property.Value).To(Equal("response-secret"))
+		Expect(buf.String()).To(ContainSubstring("/tekton_pipelines/p1/properties"))
+		Expect(buf.String()).ToNot(ContainSubstring("request-secret"))
+		Expect(buf.String()).ToNot(ContainSubstring("response-secret"))
+
+		buf.Reset()
+		service.DisableDebugLogging()
+		_, _, err = service.CreateTektonPipelineProperties(options)
+		Expect(err).To(BeNil())
+		Expect(buf.String()).To(BeEmpty())
+
+		// Logging enabled on a clone with retries does not reach the original.
+		service.EnableRetries(2, 0)
+		clone := service.Clone()
+		clone.EnableDebugLogging(logger)
+		_, _, err = service.CreateTektonPipelineProperties(options)
+		Expect(err).To(BeNil())
+		Expect(buf.String()).To(BeEmpty())
+		_, _, err = clone.CreateTektonPipelineProperties(options)
+		Expect(err).To(BeNil())
+		Expect(buf.String()).To(ContainSubstring("/tekton_pipelines/p1/properties"))
+		clone.DisableSSLVerification()
+		Expect(clone.IsSSLDisabled()).To(BeTrue())
+	})
+})
@@ -0,0 +1,29 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtektonpipelinev2
+
+import (
+	"strings"
+)
+
+// isCacheablePath reports whether a URL path is that of a GetTektonPipeline request, whose
+// responses are kept by the ResponseCache of CdTektonPipelineV2Options.
+func isCacheablePath(path string) bool {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	n := len(segments)
+	return n >= 2 && segments[n-2] == "tekton_pipelines"
+}
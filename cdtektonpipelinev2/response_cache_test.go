@@ -0,0 +1,69 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtektonpipelinev2_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtektonpipelinev2"
+	common "github.com/IBM/continuous-delivery-go-sdk/v2/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Response cache`, func() {
+	It(`Cache pipeline lookups until the pipeline is modified`, func() {
+		var requests []string
+		testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			requests = append(requests, req.Method+" "+req.URL.Path)
+			res.Header().Set("Content-type", "application/json")
+			if req.Method == "POST" {
+				res.WriteHeader(201)
+				fmt.Fprint(res, `{"id": "r1"}`)
+				return
+			}
+			fmt.Fprintf(res, `{"id": "p1", "build_number": %d}`, len(requests))
+		}))
+		defer testServer.Close()
+
+		cdTektonPipelineService, err := cdtektonpipelinev2.NewCdTektonPipelineV2(&cdtektonpipelinev2.CdTektonPipelineV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+			ResponseCache: common.NewResponseCache(nil),
+		})
+		Expect(err).To(BeNil())
+		getBuildNumber := func(id string) int64 {
+			pipeline, _, err := cdTektonPipelineService.GetTektonPipeline(cdTektonPipelineService.NewGetTektonPipelineOptions(id))
+			Expect(err).To(BeNil())
+			return *pipeline.BuildNumber
+		}
+
+		Expect(getBuildNumber("p1")).To(Equal(int64(1)))
+		Expect(getBuildNumber("p1")).To(Equal(int64(1)))
+		Expect(getBuildNumber("p2")).To(Equal(int64(2)))
+		_, _, err = cdTektonPipelineService.CreateTektonPipelineRun(cdTektonPipelineService.NewCreateTektonPipelineRunOptions("p1"))
+		Expect(err).To(BeNil())
+		Expect(getBuildNumber("p1")).To(Equal(int64(4)))
+		Expect(getBuildNumber("p2")).To(Equal(int64(2)))
+		Expect(requests).To(Equal([]string{
+			"GET /tekton_pipelines/p1", "GET /tekton_pipelines/p2", "POST /tekton_pipelines/p1/pipeline_runs", "GET /tekton_pipelines/p1",
+		}))
+	})
+})
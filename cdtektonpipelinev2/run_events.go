@@ -0,0 +1,285 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtektonpipelinev2
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtoolchainv2"
+	common "github.com/IBM/continuous-delivery-go-sdk/v2/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// PublishPipelineRunEventOptions : The PublishPipelineRunEvent options.
+type PublishPipelineRunEventOptions struct {
+	// Client for the toolchain service, used to publish the event. Required.
+	ToolchainClient *cdtoolchainv2.CdToolchainV2
+
+	// The finished pipeline run. Required.
+	PipelineRun *PipelineRun
+
+	// The build number of the run. Runs do not carry it; if 0, the event does not report one.
+	BuildNumber int64
+}
+
+// PublishPipelineRunEvent invokes PublishPipelineRunEventWithContext using context.Background().
+func (cdTektonPipeline *CdTektonPipelineV2) PublishPipelineRunEvent(options *PublishPipelineRunEventOptions) (result *cdtoolchainv2.ToolchainEventPost, err error) {
+	result, err = cdTektonPipeline.PublishPipelineRunEventWithContext(context.Background(), options)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// PublishPipelineRunEventWithContext publishes a pipeline_run_finished event, built with
+// cdtoolchainv2.NewPipelineRunFinishedEvent, to the toolchain that contains the run's pipeline.
+func (cdTektonPipeline *CdTektonPipelineV2) PublishPipelineRunEventWithContext(ctx context.Context, options *PublishPipelineRunEventOptions) (result *cdtoolchainv2.ToolchainEventPost, err error) {
+	err = core.ValidateNotNil(options, "options cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	if options.ToolchainClient == nil || options.PipelineRun == nil {
+		err = core.SDKErrorf(nil, "the 'options.ToolchainClient' and 'options.PipelineRun' fields must be set", "missing-required", common.GetComponentInfo())
+		return
+	}
+	pipeline, _, err := cdTektonPipeline.GetTektonPipelineWithContext(ctx, cdTektonPipeline.NewGetTektonPipelineOptions(core.StringNilMapper(options.PipelineRun.PipelineID)))
+	if err != nil {
+		err = core.SDKErrorf(err, "", "get-pipeline-error", common.GetComponentInfo())
+		return
+	}
+	return publishPipelineRunEvent(ctx, options.ToolchainClient, pipeline, options.PipelineRun, options.BuildNumber)
+}
+
+// publishPipelineRunEvent publishes the outcome of pipelineRun to the parent toolchain of pipeline.
+func publishPipelineRunEvent(ctx context.Context, toolchainClient *cdtoolchainv2.CdToolchainV2, pipeline *TektonPipeline, pipelineRun *PipelineRun, buildNumber int64) (result *cdtoolchainv2.ToolchainEventPost, err error) {
+	if pipeline.Toolchain == nil || pipeline.Toolchain.ID == nil {
+		err = core.SDKErrorf(nil, "the pipeline does not reference its toolchain", "missing-toolchain", common.GetComponentInfo())
+		return
+	}
+	outcome := cdtoolchainv2.PipelineRunOutcome{
+		PipelineID:   core.StringNilMapper(pipelineRun.PipelineID),
+		PipelineName: core.StringNilMapper(pipeline.Name),
+		RunID:        core.StringNilMapper(pipelineRun.ID),
+		BuildNumber:  buildNumber,
+		Status:       core.StringNilMapper(pipelineRun.Status),
+		URL:          core.StringNilMapper(pipelineRun.RunURL),
+		ErrorMessage: core.StringNilMapper(pipelineRun.ErrorMessage),
+	}
+	if pipelineRun.CreatedAt != nil {
+		outcome.StartedAt = time.Time(*pipelineRun.CreatedAt)
+	}
+	if pipelineRun.UpdatedAt != nil {
+		outcome.FinishedAt = time.Time(*pipelineRun.UpdatedAt)
+	}
+	event, err := cdtoolchainv2.NewPipelineRunFinishedEvent(outcome)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "build-event-error", common.GetComponentInfo())
+		return
+	}
+	result, _, err = toolchainClient.PublishToolchainEventWithContext(ctx, *pipeline.Toolchain.ID, event)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "publish-event-error", common.GetComponentInfo())
+	}
+	return
+}
+
+// PipelineRunEventsOptions : The EnablePipelineRunEvents options.
+type PipelineRunEventsOptions struct {
+	// Client for the toolchain service, used to publish the events. Required.
+	ToolchainClient *cdtoolchainv2.CdToolchainV2
+
+	// The time between two GetTektonPipelineRun calls. Defaults to common.DefaultWaitInterval.
+	PollInterval time.Duration
+
+	// The maximum time to wait for a run to finish. Defaults to common.DefaultWaitTimeout.
+	Timeout time.Duration
+
+	// Called once per run with the published event, or with the error that prevented it. If nil,
+	// errors are logged with the core library's logger.
+	OnPublished func(pipelineRun *PipelineRun, result *cdtoolchainv2.ToolchainEventPost, err error)
+}
+
+// PipelineRunEvents : The hook installed by EnablePipelineRunEvents.
+type PipelineRunEvents struct {
+	cdTektonPipeline *CdTektonPipelineV2
+	options          PipelineRunEventsOptions
+	ctx              context.Context
+	cancel           context.CancelFunc
+
+	// Guards pending, the number of runs being watched, and idle, signalled when it drops to 0.
+	mutex   sync.Mutex
+	pending int
+	idle    *sync.Cond
+}
+
+// EnablePipelineRunEvents installs a hook on this service instance that watches every pipeline run
+// created through it, with CreateTektonPipelineRun or any helper built on it, and publishes a
+// pipeline_run_finished event to the run's parent toolchain once the run reaches a terminal
+// status. The event carries the status, duration, run URL and, when it can be told, the build
+// number of the run. The service instance and its clones share the hook. A hook installed before
+// is replaced, but keeps watching the runs it already started watching.
+func (cdTektonPipeline *CdTektonPipelineV2) EnablePipelineRunEvents(options *PipelineRunEventsOptions) (events *PipelineRunEvents, err error) {
+	err = core.ValidateNotNil(options, "options cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	if options.ToolchainClient == nil {
+		err = core.SDKErrorf(nil, "the 'options.ToolchainClient' field must be set", "missing-toolchain-client", common.GetComponentInfo())
+		return
+	}
+	if cdTektonPipeline.pipelineRunEvents == nil {
+		// The service instance was not built by a constructor.
+		cdTektonPipeline.pipelineRunEvents = new(atomic.Pointer[PipelineRunEvents])
+		cdTektonPipeline.enableRunEventsHook()
+	}
+	events = &PipelineRunEvents{cdTektonPipeline: cdTektonPipeline, options: *options}
+	events.idle = sync.NewCond(&events.mutex)
+	events.ctx, events.cancel = context.WithCancel(context.Background())
+	cdTektonPipeline.pipelineRunEvents.Store(events)
+	return
+}
+
+// enableRunEventsHook installs the response hook that passes the pipeline runs created through
+// the service instance, and its clones made afterwards, to the hook installed by
+// EnablePipelineRunEvents, if any. The constructors install it, so that the runs are watched
+// wherever they are created, without changes to the operations.
+func (cdTektonPipeline *CdTektonPipelineV2) enableRunEventsHook() {
+	runEvents := cdTektonPipeline.pipelineRunEvents
+	common.EnableResponseHook(cdTektonPipeline.Service,
+		func(req *http.Request, res *http.Response) bool {
+			return runEvents.Load() != nil && isCreatePipelineRunRequest(req) && res.StatusCode/100 == 2
+		},
+		func(req *http.Request, res *http.Response, body []byte) {
+			var rawResponse map[string]json.RawMessage
+			var createdRun *PipelineRun
+			if json.Unmarshal(body, &rawResponse) == nil && core.UnmarshalModel(rawResponse, "", &createdRun, UnmarshalPipelineRun) == nil {
+				runEvents.Load().watch(createdRun)
+			}
+		})
+}
+
+// isCreatePipelineRunRequest returns true if req is a CreateTektonPipelineRun request.
+func isCreatePipelineRunRequest(req *http.Request) bool {
+	segments := strings.Split(strings.Trim(req.URL.Path, "/"), "/")
+	n := len(segments)
+	return req.Method == http.MethodPost && n >= 3 && segments[n-1] == "pipeline_runs" && segments[n-3] == "tekton_pipelines"
+}
+
+// runEvents returns the hook installed by EnablePipelineRunEvents, or nil.
+func (cdTektonPipeline *CdTektonPipelineV2) runEvents() *PipelineRunEvents {
+	if cdTektonPipeline.pipelineRunEvents == nil {
+		return nil
+	}
+	return cdTektonPipeline.pipelineRunEvents.Load()
+}
+
+// Wait blocks until an event was published, or failed to publish, for every run watched so far.
+func (events *PipelineRunEvents) Wait() {
+	events.mutex.Lock()
+	defer events.mutex.Unlock()
+	for events.pending > 0 {
+		events.idle.Wait()
+	}
+}
+
+// Stop removes the hook, from the service instance and its clones, and abandons the runs that
+// are still being watched.
+func (events *PipelineRunEvents) Stop() {
+	events.mutex.Lock()
+	events.cancel()
+	events.mutex.Unlock()
+	events.cdTektonPipeline.pipelineRunEvents.CompareAndSwap(events, nil)
+}
+
+// watch waits in the background for a newly created run to finish and publishes its event. It is
+// called for each run created through the service instance, and does nothing if events is nil
+// or stopped.
+func (events *PipelineRunEvents) watch(createdRun *PipelineRun) {
+	if events == nil || createdRun == nil || createdRun.ID == nil || createdRun.PipelineID == nil {
+		return
+	}
+	events.mutex.Lock()
+	defer events.mutex.Unlock()
+	if events.ctx.Err() != nil {
+		return
+	}
+	events.pending++
+	go events.publish(*createdRun.PipelineID, createdRun)
+}
+
+// publish waits for a run to finish and publishes its event.
+func (events *PipelineRunEvents) publish(pipelineID string, createdRun *PipelineRun) {
+	defer func() {
+		events.mutex.Lock()
+		events.pending--
+		if events.pending == 0 {
+			events.idle.Broadcast()
+		}
+		events.mutex.Unlock()
+	}()
+	ctx := events.ctx
+	runID := *createdRun.ID
+	pipelineRun := createdRun
+	pipeline, buildNumber, err := events.cdTektonPipeline.getPipelineAndBuildNumber(ctx, pipelineID, runID)
+	var result *cdtoolchainv2.ToolchainEventPost
+	if err == nil {
+		var finishedRun *PipelineRun
+		finishedRun, err = events.cdTektonPipeline.WaitForTektonPipelineRunWithContext(ctx, &WaitForTektonPipelineRunOptions{
+			PipelineID:   pipelineID,
+			ID:           runID,
+			PollInterval: events.options.PollInterval,
+			Timeout:      events.options.Timeout,
+		})
+		if finishedRun != nil {
+			pipelineRun = finishedRun
+		}
+		if err == nil {
+			result, err = publishPipelineRunEvent(ctx, events.options.ToolchainClient, pipeline, pipelineRun, buildNumber)
+		}
+	}
+	if events.options.OnPublished != nil {
+		events.options.OnPublished(pipelineRun, result, err)
+	} else if err != nil {
+		core.GetLogger().Error("Failed to publish the event of pipeline run '%s': %s", runID, err.Error())
+	}
+}
+
+// getPipelineAndBuildNumber gets the pipeline of a run that was just created, and the build number
+// of the run, or 0 if it cannot be told. Runs do not carry their build number; the pipeline
+// carries that of its latest run. It is therefore taken as the run's build number only if the run
+// is the latest one both before and after the pipeline is read.
+func (cdTektonPipeline *CdTektonPipelineV2) getPipelineAndBuildNumber(ctx context.Context, pipelineID string, runID string) (pipeline *TektonPipeline, buildNumber int64, err error) {
+	isLatest := func() bool {
+		runs, _, err := cdTektonPipeline.ListTektonPipelineRunsWithContext(ctx, cdTektonPipeline.NewListTektonPipelineRunsOptions(pipelineID).SetLimit(1))
+		return err == nil && len(runs.PipelineRuns) == 1 && core.StringNilMapper(runs.PipelineRuns[0].ID) == runID
+	}
+	wasLatest := isLatest()
+	pipeline, _, err = cdTektonPipeline.GetTektonPipelineWithContext(common.WithoutResponseCache(ctx), cdTektonPipeline.NewGetTektonPipelineOptions(pipelineID))
+	if err != nil {
+		return
+	}
+	if wasLatest && pipeline.BuildNumber != nil && isLatest() {
+		buildNumber = *pipeline.BuildNumber
+	}
+	return
+}
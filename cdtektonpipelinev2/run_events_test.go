@@ -0,0 +1,182 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtektonpipelinev2_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtektonpipelinev2"
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtoolchainv2"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Pipeline run events`, func() {
+	const runTemplate = `{"id": "r1", "status": "%s", "definition_id": "d1", "worker": {"id": "public"}, "pipeline_id": "p1",
+		"listener_name": "l", "trigger": {"type": "manual", "name": "t"}, "event_params_blob": "{}",
+		"created_at": "2025-03-01T10:00:00.000Z", "updated_at": "2025-03-01T10:02:05.000Z", "run_url": "https://runs/r1"}`
+	var (
+		pipelineServer          *httptest.Server
+		toolchainServer         *httptest.Server
+		cdTektonPipelineService *cdtektonpipelinev2.CdTektonPipelineV2
+		cdToolchainService      *cdtoolchainv2.CdToolchainV2
+		runGets                 int32
+		latestRunID             atomic.Value
+		mutex                   sync.Mutex
+		events                  []map[string]interface{}
+	)
+
+	BeforeEach(func() {
+		atomic.StoreInt32(&runGets, 0)
+		latestRunID.Store("r1")
+		events = nil
+		pipelineServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+			res.Header().Set("Content-type", "application/json")
+			switch req.Method + " " + req.URL.Path {
+			case "POST /tekton_pipelines/p1/pipeline_runs":
+				res.WriteHeader(201)
+				fmt.Fprintf(res, runTemplate, "pending")
+			case "GET /tekton_pipelines/p1":
+				fmt.Fprint(res, `{"id": "p1", "name": "ci", "status": "configured", "toolchain": {"id": "tc1", "crn": "crn"}, "build_number": 7}`)
+			case "GET /tekton_pipelines/p1/pipeline_runs":
+				Expect(req.URL.Query().Get("limit")).To(Equal("1"))
+				fmt.Fprintf(res, `{"limit": 1, "first": {"href": "h"}, "pipeline_runs": [{"id": "%s", "status": "pending", "definition_id": "d1",
+					"worker": {"id": "public"}, "pipeline_id": "p1", "listener_name": "l", "trigger": {"type": "manual", "name": "t"},
+					"event_params_blob": "{}", "created_at": "2025-03-01T10:00:00.000Z", "run_url": "https://runs/r1"}]}`, latestRunID.Load())
+			case "GET /tekton_pipelines/p1/pipeline_runs/r1":
+				status := "running"
+				if atomic.AddInt32(&runGets, 1) > 1 {
+					status = "failed"
+				}
+				fmt.Fprintf(res, runTemplate, status)
+			default:
+				Fail("unexpected request " + req.Method + " " + req.URL.Path)
+			}
+		}))
+		toolchainServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+			Expect(req.URL.Path).To(Equal("/toolchains/tc1/events"))
+			var body map[string]interface{}
+			Expect(json.NewDecoder(req.Body).Decode(&body)).To(Succeed())
+			mutex.Lock()
+			events = append(events, body)
+			mutex.Unlock()
+			res.Header().Set("Content-type", "application/json")
+			res.WriteHeader(202)
+			fmt.Fprint(res, `{"id": "e1"}`)
+		}))
+		var err error
+		cdTektonPipelineService, err = cdtektonpipelinev2.NewCdTektonPipelineV2(&cdtektonpipelinev2.CdTektonPipelineV2Options{
+			URL:           pipelineServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+		cdToolchainService, err = cdtoolchainv2.NewCdToolchainV2(&cdtoolchainv2.CdToolchainV2Options{
+			URL:           toolchainServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+	})
+	AfterEach(func() {
+		pipelineServer.Close()
+		toolchainServer.Close()
+	})
+
+	It(`Wait for a run to finish`, func() {
+		run, err := cdTektonPipelineService.WaitForTektonPipelineRun(&cdtektonpipelinev2.WaitForTektonPipelineRunOptions{
+			PipelineID: "p1", ID: "r1", PollInterval: time.Millisecond,
+		})
+		Expect(err).To(BeNil())
+		Expect(*run.Status).To(Equal("failed"))
+		Expect(cdtektonpipelinev2.IsTerminalPipelineRunStatus("waiting")).To(BeFalse())
+		_, err = cdTektonPipelineService.WaitForTektonPipelineRun(&cdtektonpipelinev2.WaitForTektonPipelineRunOptions{PipelineID: "p1"})
+		Expect(err).ToNot(BeNil())
+	})
+	It(`Publish an event when a run created through the SDK finishes`, func() {
+		var published []*cdtektonpipelinev2.PipelineRun
+		hook, err := cdTektonPipelineService.EnablePipelineRunEvents(&cdtektonpipelinev2.PipelineRunEventsOptions{
+			ToolchainClient: cdToolchainService,
+			PollInterval:    time.Millisecond,
+			OnPublished: func(run *cdtektonpipelinev2.PipelineRun, result *cdtoolchainv2.ToolchainEventPost, err error) {
+				defer GinkgoRecover()
+				Expect(err).To(BeNil())
+				Expect(*result.ID).To(Equal("e1"))
+				published = append(published, run)
+			},
+		})
+		Expect(err).To(BeNil())
+
+		// Runs created through a clone with retries are watched once.
+		clone := cdTektonPipelineService.Clone()
+		clone.EnableRetries(2, 0)
+		run, _, err := clone.CreateTektonPipelineRun(clone.NewCreateTektonPipelineRunOptions("p1"))
+		Expect(err).To(BeNil())
+		Expect(*run.Status).To(Equal("pending"))
+		hook.Wait()
+		Expect(published).To(HaveLen(1))
+		Expect(*published[0].Status).To(Equal("failed"))
+		Expect(events).To(HaveLen(1))
+		Expect(events[0]["title"]).To(Equal("Pipeline run #7 of ci failed"))
+		Expect(events[0]["description"]).To(Equal("Pipeline run #7 of ci failed at 2025-03-01T10:02:05Z after 2m5s. Details at https://runs/r1."))
+		pipelineRun := events[0]["data"].(map[string]interface{})["application_json"].(map[string]interface{})["content"].(map[string]interface{})["pipeline_run"].(map[string]interface{})
+		// The run is the pipeline's latest, so it has the pipeline's build number.
+		Expect(pipelineRun).To(HaveKeyWithValue("build_number", float64(7)))
+		Expect(pipelineRun).To(HaveKeyWithValue("duration_seconds", float64(125)))
+		Expect(pipelineRun).To(HaveKeyWithValue("url", "https://runs/r1"))
+
+		// Once another run is the latest, the build number of the run cannot be told.
+		atomic.StoreInt32(&runGets, 0)
+		latestRunID.Store("r2")
+		_, _, err = clone.CreateTektonPipelineRun(clone.NewCreateTektonPipelineRunOptions("p1"))
+		Expect(err).To(BeNil())
+		hook.Wait()
+		Expect(events).To(HaveLen(2))
+		pipelineRun = events[1]["data"].(map[string]interface{})["application_json"].(map[string]interface{})["content"].(map[string]interface{})["pipeline_run"].(map[string]interface{})
+		Expect(pipelineRun).ToNot(HaveKey("build_number"))
+		Expect(events[1]["title"]).To(Equal("Pipeline run r1 of ci failed"))
+
+		hook.Stop()
+		atomic.StoreInt32(&runGets, 0)
+		_, _, err = cdTektonPipelineService.CreateTektonPipelineRun(cdTektonPipelineService.NewCreateTektonPipelineRunOptions("p1"))
+		Expect(err).To(BeNil())
+		_, _, err = clone.CreateTektonPipelineRun(clone.NewCreateTektonPipelineRunOptions("p1"))
+		Expect(err).To(BeNil())
+		hook.Wait()
+		Expect(events).To(HaveLen(2))
+		Expect(atomic.LoadInt32(&runGets)).To(Equal(int32(0)))
+	})
+	It(`Publish the event of a finished run`, func() {
+		run, err := cdTektonPipelineService.WaitForTektonPipelineRun(&cdtektonpipelinev2.WaitForTektonPipelineRunOptions{
+			PipelineID: "p1", ID: "r1", PollInterval: time.Millisecond,
+		})
+		Expect(err).To(BeNil())
+		result, err := cdTektonPipelineService.PublishPipelineRunEvent(&cdtektonpipelinev2.PublishPipelineRunEventOptions{
+			ToolchainClient: cdToolchainService, PipelineRun: run,
+		})
+		Expect(err).To(BeNil())
+		Expect(*result.ID).To(Equal("e1"))
+		Expect(events[0]["title"]).To(Equal("Pipeline run r1 of ci failed"))
+	})
+})
@@ -0,0 +1,200 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtektonpipelinev2
+
+import (
+	"context"
+	"encoding/json"
+	"iter"
+	"slices"
+	"strings"
+	"time"
+
+	common "github.com/IBM/continuous-delivery-go-sdk/v2/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// TektonPipelineRunQuery : The QueryTektonPipelineRuns options. Statuses and TriggerName are
+// passed to the service when it can filter on them; every other criterion is applied on the
+// client. Empty criteria match every run.
+type TektonPipelineRunQuery struct {
+	// The Tekton pipeline ID. Required.
+	PipelineID string
+
+	// Keeps runs with one of these statuses.
+	Statuses []string
+
+	// Keeps runs started by the trigger with this name.
+	TriggerName string
+
+	// Keeps runs created at or after this time. As the service returns the most recent runs
+	// first, no further page is fetched once an older run is seen.
+	CreatedAfter time.Time
+
+	// Keeps runs created before this time.
+	CreatedBefore time.Time
+
+	// Keeps runs started manually by the user with this IAM ID or email address.
+	User string
+
+	// Keeps runs that used the worker with this ID or name.
+	Worker string
+
+	// Keeps runs of the definition with this ID.
+	DefinitionID string
+
+	// Keeps runs whose event parameters contain this text.
+	EventParamsContains string
+
+	// Keeps runs whose event parameters have these values, keyed by dot-separated paths into the
+	// JSON object, for example "repository.url". Non-string values are compared in JSON form.
+	EventParams map[string]string
+
+	// The maximum number of runs returned. Zero means no limit.
+	Limit int
+
+	// The number of runs requested per page.
+	PageSize int64
+}
+
+// QueryTektonPipelineRuns returns an iterator over the runs of a pipeline that match query, most
+// recent first. Pages are fetched while the loop runs and pagination stops as soon as the results
+// fall before CreatedAfter, the limit is reached, or the loop breaks.
+func (cdTektonPipeline *CdTektonPipelineV2) QueryTektonPipelineRuns(ctx context.Context, query *TektonPipelineRunQuery) iter.Seq2[PipelineRun, error] {
+	err := core.ValidateNotNil(query, "query cannot be nil")
+	if err != nil {
+		return common.ErrorItems[PipelineRun](core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo()))
+	}
+	if query.PipelineID == "" {
+		return common.ErrorItems[PipelineRun](core.SDKErrorf(nil, "the 'query.PipelineID' field must be set", "missing-pipeline-id", common.GetComponentInfo()))
+	}
+	options := cdTektonPipeline.NewListTektonPipelineRunsOptions(query.PipelineID)
+	if len(query.Statuses) == 1 {
+		options.SetStatus(query.Statuses[0])
+	}
+	if query.TriggerName != "" {
+		options.SetTriggerName(query.TriggerName)
+	}
+	if query.PageSize > 0 {
+		options.SetLimit(query.PageSize)
+	}
+
+	return func(yield func(PipelineRun, error) bool) {
+		found := 0
+		for run, err := range cdTektonPipeline.TektonPipelineRuns(ctx, options) {
+			if err != nil {
+				yield(run, err)
+				return
+			}
+			if !query.CreatedAfter.IsZero() && run.CreatedAt != nil && time.Time(*run.CreatedAt).Before(query.CreatedAfter) {
+				return
+			}
+			if !query.matches(&run) {
+				continue
+			}
+			if !yield(run, nil) {
+				return
+			}
+			found++
+			if query.Limit > 0 && found >= query.Limit {
+				return
+			}
+		}
+	}
+}
+
+// FindTektonPipelineRuns invokes FindTektonPipelineRunsWithContext using context.Background().
+func (cdTektonPipeline *CdTektonPipelineV2) FindTektonPipelineRuns(query *TektonPipelineRunQuery) (runs []PipelineRun, err error) {
+	runs, err = cdTektonPipeline.FindTektonPipelineRunsWithContext(context.Background(), query)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// FindTektonPipelineRunsWithContext returns the runs yielded by QueryTektonPipelineRuns.
+func (cdTektonPipeline *CdTektonPipelineV2) FindTektonPipelineRunsWithContext(ctx context.Context, query *TektonPipelineRunQuery) (runs []PipelineRun, err error) {
+	for run, runErr := range cdTektonPipeline.QueryTektonPipelineRuns(ctx, query) {
+		if runErr != nil {
+			return nil, runErr
+		}
+		runs = append(runs, run)
+	}
+	return
+}
+
+// matches applies the client-side criteria, except CreatedAfter, to a run.
+func (query *TektonPipelineRunQuery) matches(run *PipelineRun) bool {
+	if len(query.Statuses) > 1 && !slices.Contains(query.Statuses, core.StringNilMapper(run.Status)) {
+		return false
+	}
+	if !query.CreatedBefore.IsZero() && (run.CreatedAt == nil || !time.Time(*run.CreatedAt).Before(query.CreatedBefore)) {
+		return false
+	}
+	if query.User != "" && (run.UserInfo == nil ||
+		(core.StringNilMapper(run.UserInfo.IamID) != query.User && core.StringNilMapper(run.UserInfo.Sub) != query.User)) {
+		return false
+	}
+	if query.Worker != "" && (run.Worker == nil ||
+		(core.StringNilMapper(run.Worker.ID) != query.Worker && core.StringNilMapper(run.Worker.Name) != query.Worker)) {
+		return false
+	}
+	if query.DefinitionID != "" && core.StringNilMapper(run.DefinitionID) != query.DefinitionID {
+		return false
+	}
+	blob := core.StringNilMapper(run.EventParamsBlob)
+	if query.EventParamsContains != "" && !strings.Contains(blob, query.EventParamsContains) {
+		return false
+	}
+	if len(query.EventParams) > 0 {
+		var params map[string]interface{}
+		if json.Unmarshal([]byte(blob), &params) != nil {
+			return false
+		}
+		for key, want := range query.EventParams {
+			value, ok := eventParam(params, key)
+			if !ok || value != want {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// eventParam returns the value at a dot-separated path of the event parameters, as a string.
+func eventParam(params map[string]interface{}, key string) (string, bool) {
+	var value interface{} = params
+	for _, name := range strings.Split(key, ".") {
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return "", false
+		}
+		if value, ok = object[name]; !ok {
+			return "", false
+		}
+	}
+	switch value := value.(type) {
+	case string:
+		return value, true
+	case nil:
+		return "null", true
+	default:
+		b, err := json.Marshal(value)
+		if err != nil {
+			return "", false
+		}
+		return string(b), true
+	}
+}
@@ -0,0 +1,164 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtektonpipelinev2_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtektonpipelinev2"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`QueryTektonPipelineRuns`, func() {
+	var (
+		testServer              *httptest.Server
+		cdTektonPipelineService *cdtektonpipelinev2.CdTektonPipelineV2
+		queries                 []string
+	)
+
+	// Two pages of runs, newest first, created one hour apart from 2025-03-01T10:00:00Z down.
+	run := func(i int, status string, user string, worker string, definition string, params string) map[string]interface{} {
+		return map[string]interface{}{
+			"id": fmt.Sprintf("r%d", i), "status": status, "definition_id": definition, "pipeline_id": "p1",
+			"worker": map[string]string{"id": worker, "name": worker + "-name"}, "listener_name": "l",
+			"trigger": map[string]string{"type": "manual", "name": "t"}, "event_params_blob": params,
+			"user_info":  map[string]string{"iam_id": "IBMid-" + user, "sub": user + "@example.com"},
+			"created_at": time.Date(2025, 3, 1, 10-i, 0, 0, 0, time.UTC).Format(time.RFC3339),
+			"run_url":    "https://runs",
+		}
+	}
+	pages := [][]map[string]interface{}{
+		{
+			run(0, "running", "alice", "public", "d1", `{"repository":{"url":"https://repo/a"},"ref":"main"}`),
+			run(1, "succeeded", "bob", "private", "d1", `{"repository": {"url": "https://repo/b"}, "pr": 12}`),
+			run(2, "failed", "alice", "public", "d2", `{"repository": {"url": "https://repo/a"}, "ref": "dev"}`),
+		},
+		{
+			run(3, "succeeded", "alice", "public", "d1", `{"repository":{"url":"https://repo/a"},"ref":"main"}`),
+			run(4, "failed", "bob", "public", "d1", `{}`),
+		},
+	}
+
+	BeforeEach(func() {
+		queries = nil
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+			Expect(req.URL.Path).To(Equal("/tekton_pipelines/p1/pipeline_runs"))
+			queries = append(queries, req.URL.RawQuery)
+			body := map[string]interface{}{"limit": 3, "first": map[string]string{"href": testServer.URL}}
+			if req.URL.Query().Get("start") == "" {
+				body["pipeline_runs"] = pages[0]
+				body["next"] = map[string]string{"href": testServer.URL + "?start=s2"}
+			} else {
+				body["pipeline_runs"] = pages[1]
+			}
+			res.Header().Set("Content-type", "application/json")
+			Expect(json.NewEncoder(res).Encode(body)).To(Succeed())
+		}))
+		var err error
+		cdTektonPipelineService, err = cdtektonpipelinev2.NewCdTektonPipelineV2(&cdtektonpipelinev2.CdTektonPipelineV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	ids := func(runs []cdtektonpipelinev2.PipelineRun) (ids []string) {
+		for _, run := range runs {
+			ids = append(ids, *run.ID)
+		}
+		return
+	}
+
+	It(`Filter runs on the client`, func() {
+		runs, err := cdTektonPipelineService.FindTektonPipelineRuns(&cdtektonpipelinev2.TektonPipelineRunQuery{
+			PipelineID: "p1", User: "alice@example.com", Worker: "public-name", DefinitionID: "d1",
+		})
+		Expect(err).To(BeNil())
+		Expect(ids(runs)).To(Equal([]string{"r0", "r3"}))
+
+		runs, err = cdTektonPipelineService.FindTektonPipelineRuns(&cdtektonpipelinev2.TektonPipelineRunQuery{
+			PipelineID: "p1", Statuses: []string{"failed", "succeeded"}, EventParams: map[string]string{"repository.url": "https://repo/a"},
+		})
+		Expect(err).To(BeNil())
+		Expect(ids(runs)).To(Equal([]string{"r2", "r3"}))
+
+		runs, err = cdTektonPipelineService.FindTektonPipelineRuns(&cdtektonpipelinev2.TektonPipelineRunQuery{
+			PipelineID: "p1", EventParams: map[string]string{"pr": "12"}, User: "IBMid-bob",
+		})
+		Expect(err).To(BeNil())
+		Expect(ids(runs)).To(Equal([]string{"r1"}))
+
+		runs, err = cdTektonPipelineService.FindTektonPipelineRuns(&cdtektonpipelinev2.TektonPipelineRunQuery{
+			PipelineID: "p1", EventParamsContains: `"ref":"main"`,
+		})
+		Expect(err).To(BeNil())
+		Expect(ids(runs)).To(Equal([]string{"r0", "r3"}))
+	})
+	It(`Pass the status and trigger name to the service`, func() {
+		_, err := cdTektonPipelineService.FindTektonPipelineRuns(&cdtektonpipelinev2.TektonPipelineRunQuery{
+			PipelineID: "p1", Statuses: []string{"failed"}, TriggerName: "nightly", PageSize: 3,
+		})
+		Expect(err).To(BeNil())
+		Expect(queries[0]).To(Equal("limit=3&status=failed&trigger.name=nightly"))
+	})
+	It(`Stop paginating at the end of the time window or the limit`, func() {
+		runs, err := cdTektonPipelineService.FindTektonPipelineRuns(&cdtektonpipelinev2.TektonPipelineRunQuery{
+			PipelineID:    "p1",
+			CreatedAfter:  time.Date(2025, 3, 1, 8, 0, 0, 0, time.UTC),
+			CreatedBefore: time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC),
+		})
+		Expect(err).To(BeNil())
+		Expect(ids(runs)).To(Equal([]string{"r1", "r2"}))
+		Expect(queries).To(HaveLen(2))
+
+		queries = nil
+		runs, err = cdTektonPipelineService.FindTektonPipelineRuns(&cdtektonpipelinev2.TektonPipelineRunQuery{
+			PipelineID: "p1", CreatedAfter: time.Date(2025, 3, 1, 8, 30, 0, 0, time.UTC),
+		})
+		Expect(err).To(BeNil())
+		Expect(ids(runs)).To(Equal([]string{"r0", "r1"}))
+		Expect(queries).To(HaveLen(1))
+
+		queries = nil
+		query := &cdtektonpipelinev2.TektonPipelineRunQuery{PipelineID: "p1", Limit: 2}
+		runs, err = cdTektonPipelineService.FindTektonPipelineRuns(query)
+		Expect(err).To(BeNil())
+		Expect(ids(runs)).To(Equal([]string{"r0", "r1"}))
+		Expect(queries).To(HaveLen(1))
+
+		count := 0
+		for range cdTektonPipelineService.QueryTektonPipelineRuns(context.Background(), query) {
+			count++
+		}
+		Expect(count).To(Equal(2))
+		Expect(queries).To(HaveLen(2))
+
+		_, err = cdTektonPipelineService.FindTektonPipelineRuns(&cdtektonpipelinev2.TektonPipelineRunQuery{})
+		Expect(err).ToNot(BeNil())
+	})
+})
@@ -0,0 +1,284 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtektonpipelinev2
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	common "github.com/IBM/continuous-delivery-go-sdk/v2/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Schemes of the secret resolvers provided by this package.
+const (
+	SecretReferenceSchemeEnvConst   = "env"
+	SecretReferenceSchemeFileConst  = "file"
+	SecretReferenceSchemeVaultConst = "vault"
+)
+
+// SecretReference : A reference to a secret held outside of the pipeline, written as
+// `scheme:path` or `scheme:path#key`, for example `vault:secret/data/app#api_key`.
+type SecretReference struct {
+	// Selects the SecretResolver, for example "vault".
+	Scheme string
+
+	// Identifies the secret within the provider: a Vault path, a file path or a variable name.
+	Path string
+
+	// Optional field of a structured secret.
+	Key string
+}
+
+var secretReferenceRegexp = regexp.MustCompile(`^([a-z][a-z0-9_-]*):([^#]+)(?:#(.+))?$`)
+
+// ParseSecretReference parses a value of the form `scheme:path#key`. It returns false if the value
+// is not a secret reference. URLs such as `https://host/path` are not secret references.
+func ParseSecretReference(value string) (reference SecretReference, ok bool) {
+	matches := secretReferenceRegexp.FindStringSubmatch(value)
+	if matches == nil || strings.HasPrefix(matches[2], "//") {
+		return
+	}
+	return SecretReference{Scheme: matches[1], Path: matches[2], Key: matches[3]}, true
+}
+
+// String returns the reference in its `scheme:path#key` form.
+func (reference SecretReference) String() string {
+	if reference.Key == "" {
+		return reference.Scheme + ":" + reference.Path
+	}
+	return reference.Scheme + ":" + reference.Path + "#" + reference.Key
+}
+
+// SecretResolver : Looks up the value of a secret reference.
+type SecretResolver interface {
+	ResolveSecret(ctx context.Context, reference SecretReference) (string, error)
+}
+
+// SecretResolvers : The secret resolvers to use, keyed by reference scheme.
+type SecretResolvers map[string]SecretResolver
+
+// ResolveValue resolves value if it is a reference whose scheme has a resolver, and returns it
+// unchanged otherwise. The returned flag reports whether value was a resolved reference.
+func (resolvers SecretResolvers) ResolveValue(ctx context.Context, value string) (resolved string, isReference bool, err error) {
+	reference, ok := ParseSecretReference(value)
+	if !ok || resolvers[reference.Scheme] == nil {
+		return value, false, nil
+	}
+	resolved, err = resolvers[reference.Scheme].ResolveSecret(ctx, reference)
+	if err != nil {
+		err = core.SDKErrorf(err, fmt.Sprintf("unable to resolve secret reference '%s'", reference), "secret-resolution-failed", common.GetComponentInfo())
+		return "", true, err
+	}
+	return resolved, true, nil
+}
+
+// resolveProperty returns a copy of a `secure` property whose value is a secret reference, with
+// the reference replaced by the secret. Other properties are returned as is.
+func (resolvers SecretResolvers) resolveProperty(ctx context.Context, property *Property) (*Property, error) {
+	if len(resolvers) == 0 || core.StringNilMapper(property.Type) != PropertyTypeSecureConst || property.Value == nil {
+		return property, nil
+	}
+	value, isReference, err := resolvers.ResolveValue(ctx, *property.Value)
+	if err != nil || !isReference {
+		return property, err
+	}
+	resolved := *property
+	resolved.Value = &value
+	return &resolved, nil
+}
+
+// EnvSecretResolver : Resolves `env:NAME` references from environment variables. With a key, the
+// variable is expected to hold a JSON object or `KEY=VALUE` lines.
+type EnvSecretResolver struct {
+	// Optional prefix prepended to every variable name.
+	Prefix string
+}
+
+// ResolveSecret implements SecretResolver.
+func (resolver *EnvSecretResolver) ResolveSecret(ctx context.Context, reference SecretReference) (string, error) {
+	content, ok := os.LookupEnv(resolver.Prefix + reference.Path)
+	if !ok {
+		return "", fmt.Errorf("environment variable '%s' is not set", resolver.Prefix+reference.Path)
+	}
+	return selectSecretKey(content, reference.Key)
+}
+
+// FileSecretResolver : Resolves `file:path` references from file contents, ignoring a trailing
+// newline. With a key, the file is expected to hold a JSON object or `KEY=VALUE` lines.
+type FileSecretResolver struct {
+	// Directory that relative paths are resolved against. Defaults to the working directory.
+	Dir string
+}
+
+// ResolveSecret implements SecretResolver.
+func (resolver *FileSecretResolver) ResolveSecret(ctx context.Context, reference SecretReference) (string, error) {
+	path := reference.Path
+	if resolver.Dir != "" && !filepath.IsAbs(path) {
+		path = filepath.Join(resolver.Dir, path)
+	}
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	return selectSecretKey(string(content), reference.Key)
+}
+
+// VaultSecretResolver : Resolves `vault:path#key` references by reading path from the HTTP API of
+// HashiCorp Vault or a compatible server. Both KV version 1 and version 2 responses are supported;
+// for version 2 the path includes the `data/` segment, as in `vault:secret/data/app#api_key`.
+// The key may be omitted if the secret has a single field.
+type VaultSecretResolver struct {
+	// The server address, for example "https://vault.example.com:8200".
+	Address string
+
+	// The token sent in the X-Vault-Token header.
+	Token string
+
+	// Optional namespace sent in the X-Vault-Namespace header.
+	Namespace string
+
+	// The HTTP client. If nil, http.DefaultClient is used.
+	Client *http.Client
+}
+
+// ResolveSecret implements SecretResolver.
+func (resolver *VaultSecretResolver) ResolveSecret(ctx context.Context, reference SecretReference) (string, error) {
+	url := strings.TrimSuffix(resolver.Address, "/") + "/v1/" + strings.TrimPrefix(reference.Path, "/")
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Accept", "application/json")
+	if resolver.Token != "" {
+		req.Header.Set("X-Vault-Token", resolver.Token)
+	}
+	if resolver.Namespace != "" {
+		req.Header.Set("X-Vault-Namespace", resolver.Namespace)
+	}
+	client := resolver.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	res, err := client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer res.Body.Close()
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return "", err
+	}
+	if res.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("reading '%s' returned status %d", reference.Path, res.StatusCode)
+	}
+
+	var secret struct {
+		Data map[string]interface{} `json:"data"`
+	}
+	if err = json.Unmarshal(body, &secret); err != nil {
+		return "", fmt.Errorf("reading '%s' returned an invalid response: %s", reference.Path, err.Error())
+	}
+	data := secret.Data
+	if inner, ok := data["data"].(map[string]interface{}); ok {
+		if _, ok = data["metadata"]; ok {
+			data = inner
+		}
+	}
+	key := reference.Key
+	if key == "" {
+		if len(data) != 1 {
+			return "", fmt.Errorf("secret '%s' has %d fields, a key must be specified", reference.Path, len(data))
+		}
+		for name := range data {
+			key = name
+		}
+	}
+	return secretField(data, key)
+}
+
+// selectSecretKey returns content without its trailing newline, or the field key of content when
+// key is set.
+func selectSecretKey(content string, key string) (string, error) {
+	if key == "" {
+		return strings.TrimRight(content, "\r\n"), nil
+	}
+	var data map[string]interface{}
+	if json.Unmarshal([]byte(content), &data) == nil {
+		return secretField(data, key)
+	}
+	scanner := bufio.NewScanner(strings.NewReader(content))
+	for scanner.Scan() {
+		name, value, found := strings.Cut(strings.TrimSpace(scanner.Text()), "=")
+		if found && strings.TrimSpace(strings.TrimPrefix(name, "export ")) == key {
+			return strings.Trim(strings.TrimSpace(value), `"'`), nil
+		}
+	}
+	return "", fmt.Errorf("key '%s' not found", key)
+}
+
+func secretField(data map[string]interface{}, key string) (string, error) {
+	value, ok := data[key]
+	if !ok || value == nil {
+		return "", fmt.Errorf("key '%s' not found", key)
+	}
+	if str, ok := value.(string); ok {
+		return str, nil
+	}
+	b, err := json.Marshal(value)
+	return string(b), err
+}
+
+// CreateTektonPipelineRunWithSecrets invokes CreateTektonPipelineRunWithSecretsWithContext using context.Background().
+func (cdTektonPipeline *CdTektonPipelineV2) CreateTektonPipelineRunWithSecrets(createTektonPipelineRunOptions *CreateTektonPipelineRunOptions, resolvers SecretResolvers) (result *PipelineRun, response *core.DetailedResponse, err error) {
+	result, response, err = cdTektonPipeline.CreateTektonPipelineRunWithSecretsWithContext(context.Background(), createTektonPipelineRunOptions, resolvers)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// CreateTektonPipelineRunWithSecretsWithContext starts a pipeline run after resolving the secret
+// references among the string values of SecureTriggerProperties. The resolved values are only
+// placed in the request; createTektonPipelineRunOptions is not modified.
+func (cdTektonPipeline *CdTektonPipelineV2) CreateTektonPipelineRunWithSecretsWithContext(ctx context.Context, createTektonPipelineRunOptions *CreateTektonPipelineRunOptions, resolvers SecretResolvers) (result *PipelineRun, response *core.DetailedResponse, err error) {
+	err = core.ValidateNotNil(createTektonPipelineRunOptions, "createTektonPipelineRunOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	options := *createTektonPipelineRunOptions
+	if options.SecureTriggerProperties != nil {
+		options.SecureTriggerProperties = make(map[string]interface{}, len(createTektonPipelineRunOptions.SecureTriggerProperties))
+		for name, value := range createTektonPipelineRunOptions.SecureTriggerProperties {
+			if str, ok := value.(string); ok {
+				value, _, err = resolvers.ResolveValue(ctx, str)
+				if err != nil {
+					return
+				}
+			}
+			options.SecureTriggerProperties[name] = value
+		}
+	}
+	return cdTektonPipeline.CreateTektonPipelineRunWithContext(ctx, &options)
+}
@@ -0,0 +1,187 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtektonpipelinev2_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtektonpipelinev2"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`SecretResolver`, func() {
+	var (
+		dir         string
+		vaultServer *httptest.Server
+		resolvers   cdtektonpipelinev2.SecretResolvers
+	)
+	ctx := context.Background()
+
+	BeforeEach(func() {
+		var err error
+		dir, err = os.MkdirTemp("", "secrets")
+		Expect(err).To(BeNil())
+		Expect(os.WriteFile(filepath.Join(dir, "token"), []byte("file-token\n"), 0600)).To(Succeed())
+		Expect(os.WriteFile(filepath.Join(dir, "app.env"), []byte("export USER=admin\nPASSWORD=\"file-password\"\n"), 0600)).To(Succeed())
+		Expect(os.WriteFile(filepath.Join(dir, "app.json"), []byte(`{"api_key": "json-key", "port": 8080}`), 0600)).To(Succeed())
+		os.Setenv("SECRET_TEST_TOKEN", "env-token")
+
+		vaultServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+			Expect(req.Header.Get("X-Vault-Token")).To(Equal("root"))
+			Expect(req.Header.Get("X-Vault-Namespace")).To(Equal("team"))
+			switch req.URL.Path {
+			case "/v1/secret/data/app":
+				fmt.Fprint(res, `{"data": {"data": {"api_key": "vault-key", "user": "bot"}, "metadata": {"version": 3}}}`)
+			case "/v1/kv/single":
+				fmt.Fprint(res, `{"data": {"password": "vault-password"}}`)
+			default:
+				res.WriteHeader(404)
+				fmt.Fprint(res, `{"errors": []}`)
+			}
+		}))
+		resolvers = cdtektonpipelinev2.SecretResolvers{
+			cdtektonpipelinev2.SecretReferenceSchemeEnvConst:   &cdtektonpipelinev2.EnvSecretResolver{Prefix: "SECRET_TEST_"},
+			cdtektonpipelinev2.SecretReferenceSchemeFileConst:  &cdtektonpipelinev2.FileSecretResolver{Dir: dir},
+			cdtektonpipelinev2.SecretReferenceSchemeVaultConst: &cdtektonpipelinev2.VaultSecretResolver{Address: vaultServer.URL, Token: "root", Namespace: "team"},
+		}
+	})
+	AfterEach(func() {
+		vaultServer.Close()
+		os.RemoveAll(dir)
+		os.Unsetenv("SECRET_TEST_TOKEN")
+	})
+
+	It(`Parse secret references`, func() {
+		reference, ok := cdtektonpipelinev2.ParseSecretReference("vault:secret/data/app#api_key")
+		Expect(ok).To(BeTrue())
+		Expect(reference).To(Equal(cdtektonpipelinev2.SecretReference{Scheme: "vault", Path: "secret/data/app", Key: "api_key"}))
+		Expect(reference.String()).To(Equal("vault:secret/data/app#api_key"))
+		_, ok = cdtektonpipelinev2.ParseSecretReference("https://example.com/x")
+		Expect(ok).To(BeFalse())
+		_, ok = cdtektonpipelinev2.ParseSecretReference("plain value")
+		Expect(ok).To(BeFalse())
+	})
+	It(`Resolve references with each resolver`, func() {
+		for value, expected := range map[string]string{
+			"env:TOKEN":                     "env-token",
+			"file:token":                    "file-token",
+			"file:app.env#PASSWORD":         "file-password",
+			"file:app.json#api_key":         "json-key",
+			"file:app.json#port":            "8080",
+			"vault:secret/data/app#api_key": "vault-key",
+			"vault:kv/single":               "vault-password",
+			"other:not/registered":          "other:not/registered",
+			"just text":                     "just text",
+		} {
+			resolved, _, err := resolvers.ResolveValue(ctx, value)
+			Expect(err).To(BeNil(), value)
+			Expect(resolved).To(Equal(expected), value)
+		}
+	})
+	It(`Report unresolvable references`, func() {
+		for _, value := range []string{"env:MISSING", "file:missing", "file:app.json#missing", "vault:secret/data/app", "vault:kv/missing#x"} {
+			_, isReference, err := resolvers.ResolveValue(ctx, value)
+			Expect(isReference).To(BeTrue())
+			Expect(err).ToNot(BeNil(), value)
+			Expect(err.Error()).To(ContainSubstring(value))
+		}
+	})
+
+	Context(`Using the pipeline service`, func() {
+		var (
+			testServer              *httptest.Server
+			cdTektonPipelineService *cdtektonpipelinev2.CdTektonPipelineV2
+			bodies                  []map[string]interface{}
+		)
+		BeforeEach(func() {
+			bodies = nil
+			testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+				res.Header().Set("Content-type", "application/json")
+				if req.Method == "GET" {
+					fmt.Fprint(res, `{"properties": [{"name": "token", "type": "secure", "value": "hash"}]}`)
+					return
+				}
+				var body map[string]interface{}
+				Expect(json.NewDecoder(req.Body).Decode(&body)).To(Succeed())
+				bodies = append(bodies, body)
+				res.WriteHeader(201)
+				fmt.Fprint(res, `{"id": "r1"}`)
+			}))
+			var err error
+			cdTektonPipelineService, err = cdtektonpipelinev2.NewCdTektonPipelineV2(&cdtektonpipelinev2.CdTektonPipelineV2Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(err).To(BeNil())
+		})
+		AfterEach(func() {
+			testServer.Close()
+		})
+
+		It(`Invoke SyncTektonPipelineProperties with secret references`, func() {
+			desired := []cdtektonpipelinev2.Property{
+				{Name: core.StringPtr("token"), Type: core.StringPtr("secure"), Value: core.StringPtr("vault:secret/data/app#api_key")},
+				{Name: core.StringPtr("password"), Type: core.StringPtr("secure"), Value: core.StringPtr("file:app.env#PASSWORD")},
+				{Name: core.StringPtr("note"), Type: core.StringPtr("text"), Value: core.StringPtr("env:TOKEN")},
+			}
+			result, err := cdTektonPipelineService.SyncTektonPipelineProperties(&cdtektonpipelinev2.SyncTektonPipelinePropertiesOptions{
+				PipelineID:      "p1",
+				Properties:      desired,
+				SecretResolvers: resolvers,
+			})
+			Expect(err).To(BeNil())
+			Expect(result.Replaced).To(Equal([]string{"token"}))
+			Expect(result.Created).To(Equal([]string{"password", "note"}))
+			Expect(bodies).To(HaveLen(3))
+			Expect(bodies[0]["value"]).To(Equal("vault-key"))
+			Expect(bodies[1]["value"]).To(Equal("file-password"))
+			Expect(bodies[2]["value"]).To(Equal("env:TOKEN"))
+			Expect(*desired[0].Value).To(Equal("vault:secret/data/app#api_key"))
+
+			_, err = cdTektonPipelineService.SyncTektonPipelineProperties(&cdtektonpipelinev2.SyncTektonPipelinePropertiesOptions{
+				PipelineID:      "p1",
+				Properties:      []cdtektonpipelinev2.Property{{Name: core.StringPtr("token"), Type: core.StringPtr("secure"), Value: core.StringPtr("env:MISSING")}},
+				SecretResolvers: resolvers,
+			})
+			Expect(err).ToNot(BeNil())
+			Expect(bodies).To(HaveLen(3))
+		})
+		It(`Invoke CreateTektonPipelineRunWithSecrets`, func() {
+			options := cdTektonPipelineService.NewCreateTektonPipelineRunOptions("p1")
+			options.SetTriggerName("manual")
+			options.SetSecureTriggerProperties(map[string]interface{}{"token": "env:TOKEN", "literal": "value"})
+			run, _, err := cdTektonPipelineService.CreateTektonPipelineRunWithSecrets(options, resolvers)
+			Expect(err).To(BeNil())
+			Expect(*run.ID).To(Equal("r1"))
+			Expect(bodies[0]["secure_trigger_properties"]).To(Equal(map[string]interface{}{"token": "env-token", "literal": "value"}))
+			Expect(options.SecureTriggerProperties["token"]).To(Equal("env:TOKEN"))
+
+			_, _, err = cdTektonPipelineService.CreateTektonPipelineRunWithSecrets(nil, resolvers)
+			Expect(err).ToNot(BeNil())
+		})
+	})
+})
@@ -0,0 +1,290 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtektonpipelinev2
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"path"
+	"time"
+
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtoolchainv2"
+	common "github.com/IBM/continuous-delivery-go-sdk/v2/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Constants associated with the SecretRotationEntry.Status property.
+const (
+	SecretRotationStatusReplacedConst     = "replaced"
+	SecretRotationStatusWouldReplaceConst = "would_replace"
+	SecretRotationStatusFailedConst       = "failed"
+)
+
+// RotateSecurePropertiesOptions : The RotateSecureProperties options.
+type RotateSecurePropertiesOptions struct {
+	// Client for the toolchain service, used to find the pipelines of each toolchain.
+	ToolchainClient *cdtoolchainv2.CdToolchainV2
+
+	// The toolchains to scan. When empty, every toolchain of ResourceGroupID is scanned.
+	ToolchainIDs []string
+
+	// The resource group whose toolchains are scanned when ToolchainIDs is empty.
+	ResourceGroupID string
+
+	// Glob pattern, as accepted by path.Match, selecting the secure properties to rotate by name.
+	NamePattern string
+
+	// The new value. It may be a secret reference when SecretResolvers is set.
+	Value string
+
+	// Optional resolvers for a Value that is a secret reference. The reference is resolved once,
+	// before any property is replaced.
+	SecretResolvers SecretResolvers
+
+	// When true, the matching properties are reported but not replaced.
+	DryRun bool
+}
+
+// SecretRotationEntry : The outcome for one secure property, or for a toolchain or pipeline that
+// could not be scanned, in which case Property is empty.
+type SecretRotationEntry struct {
+	ToolchainID string `json:"toolchain_id"`
+	PipelineID  string `json:"pipeline_id,omitempty"`
+	TriggerID   string `json:"trigger_id,omitempty"`
+	TriggerName string `json:"trigger_name,omitempty"`
+	Property    string `json:"property,omitempty"`
+
+	// One of the SecretRotationStatus constants.
+	Status string `json:"status"`
+
+	Error string `json:"error,omitempty"`
+}
+
+// SecretRotationReport : The audit report of a secure property rotation. It never contains values.
+type SecretRotationReport struct {
+	NamePattern      string                `json:"name_pattern"`
+	DryRun           bool                  `json:"dry_run"`
+	StartedAt        time.Time             `json:"started_at"`
+	FinishedAt       time.Time             `json:"finished_at"`
+	PipelinesScanned int                   `json:"pipelines_scanned"`
+	Entries          []SecretRotationEntry `json:"entries"`
+}
+
+// Failed returns the entries with status "failed".
+func (report *SecretRotationReport) Failed() (entries []SecretRotationEntry) {
+	for _, entry := range report.Entries {
+		if entry.Status == SecretRotationStatusFailedConst {
+			entries = append(entries, entry)
+		}
+	}
+	return
+}
+
+// WriteJSON renders the report as indented JSON.
+func (report *SecretRotationReport) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(report)
+}
+
+// RotateSecureProperties invokes RotateSecurePropertiesWithContext using context.Background().
+func (cdTektonPipeline *CdTektonPipelineV2) RotateSecureProperties(options *RotateSecurePropertiesOptions) (report *SecretRotationReport, err error) {
+	report, err = cdTektonPipeline.RotateSecurePropertiesWithContext(context.Background(), options)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// RotateSecurePropertiesWithContext replaces the value of every `secure` pipeline and trigger
+// property whose name matches options.NamePattern, in every Tekton pipeline of the selected
+// toolchains. Failures to scan a toolchain or pipeline, or to replace a property, are recorded in
+// the report and do not stop the rotation; an error is only returned if the options are invalid,
+// the value cannot be resolved or the toolchains cannot be listed.
+func (cdTektonPipeline *CdTektonPipelineV2) RotateSecurePropertiesWithContext(ctx context.Context, options *RotateSecurePropertiesOptions) (report *SecretRotationReport, err error) {
+	err = core.ValidateNotNil(options, "options cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	if options.ToolchainClient == nil || options.NamePattern == "" || options.Value == "" {
+		err = core.SDKErrorf(nil, "the 'options.ToolchainClient', 'options.NamePattern' and 'options.Value' fields must be set", "missing-required-option", common.GetComponentInfo())
+		return
+	}
+	if len(options.ToolchainIDs) == 0 && options.ResourceGroupID == "" {
+		err = core.SDKErrorf(nil, "one of the 'options.ToolchainIDs' or 'options.ResourceGroupID' fields must be set", "missing-required-option", common.GetComponentInfo())
+		return
+	}
+	if _, err = path.Match(options.NamePattern, ""); err != nil {
+		err = core.SDKErrorf(err, "invalid name pattern", "invalid-name-pattern", common.GetComponentInfo())
+		return
+	}
+	value, _, err := options.SecretResolvers.ResolveValue(ctx, options.Value)
+	if err != nil {
+		return
+	}
+
+	toolchainIDs := options.ToolchainIDs
+	if len(toolchainIDs) == 0 {
+		toolchainIDs, err = listToolchainIDs(ctx, options.ToolchainClient, options.ResourceGroupID)
+		if err != nil {
+			return
+		}
+	}
+
+	rotation := &secretRotation{client: cdTektonPipeline, options: options, value: value}
+	rotation.report = &SecretRotationReport{NamePattern: options.NamePattern, DryRun: options.DryRun, StartedAt: time.Now(), Entries: []SecretRotationEntry{}}
+	for _, toolchainID := range toolchainIDs {
+		rotation.rotateToolchain(ctx, toolchainID)
+	}
+	rotation.report.FinishedAt = time.Now()
+	report = rotation.report
+	return
+}
+
+func listToolchainIDs(ctx context.Context, client *cdtoolchainv2.CdToolchainV2, resourceGroupID string) (ids []string, err error) {
+	pager, err := client.NewToolchainsPager(client.NewListToolchainsOptions(resourceGroupID))
+	if err != nil {
+		return
+	}
+	for pager.HasNext() {
+		var page []cdtoolchainv2.ToolchainModel
+		page, err = pager.GetNextWithContext(ctx)
+		if err != nil {
+			return
+		}
+		for _, toolchain := range page {
+			ids = append(ids, core.StringNilMapper(toolchain.ID))
+		}
+	}
+	return
+}
+
+// secretRotation holds the state of a RotateSecureProperties call.
+type secretRotation struct {
+	client  *CdTektonPipelineV2
+	options *RotateSecurePropertiesOptions
+	value   string
+	report  *SecretRotationReport
+}
+
+func (rotation *secretRotation) fail(entry SecretRotationEntry, err error) {
+	entry.Status = SecretRotationStatusFailedConst
+	entry.Error = err.Error()
+	rotation.report.Entries = append(rotation.report.Entries, entry)
+}
+
+func (rotation *secretRotation) succeed(entry SecretRotationEntry) {
+	entry.Status = SecretRotationStatusReplacedConst
+	if rotation.options.DryRun {
+		entry.Status = SecretRotationStatusWouldReplaceConst
+	}
+	rotation.report.Entries = append(rotation.report.Entries, entry)
+}
+
+func (rotation *secretRotation) matches(propertyType *string, name *string) bool {
+	if core.StringNilMapper(propertyType) != PropertyTypeSecureConst {
+		return false
+	}
+	matched, _ := path.Match(rotation.options.NamePattern, core.StringNilMapper(name))
+	return matched
+}
+
+func (rotation *secretRotation) rotateToolchain(ctx context.Context, toolchainID string) {
+	client := rotation.options.ToolchainClient
+	pager, err := client.NewToolsPager(client.NewListToolsOptions(toolchainID))
+	if err != nil {
+		rotation.fail(SecretRotationEntry{ToolchainID: toolchainID}, err)
+		return
+	}
+	var pipelineIDs []string
+	for pager.HasNext() {
+		page, err := pager.GetNextWithContext(ctx)
+		if err != nil {
+			rotation.fail(SecretRotationEntry{ToolchainID: toolchainID}, err)
+			return
+		}
+		for i := range page {
+			if isTektonPipelineTool(&page[i]) {
+				pipelineIDs = append(pipelineIDs, core.StringNilMapper(page[i].ID))
+			}
+		}
+	}
+	for _, pipelineID := range pipelineIDs {
+		rotation.rotatePipeline(ctx, toolchainID, pipelineID)
+	}
+}
+
+func (rotation *secretRotation) rotatePipeline(ctx context.Context, toolchainID string, pipelineID string) {
+	client := rotation.client
+	properties, _, err := client.ListTektonPipelinePropertiesWithContext(ctx, client.NewListTektonPipelinePropertiesOptions(pipelineID))
+	if err != nil {
+		rotation.fail(SecretRotationEntry{ToolchainID: toolchainID, PipelineID: pipelineID}, err)
+		return
+	}
+	rotation.report.PipelinesScanned++
+	for _, property := range properties.Properties {
+		if !rotation.matches(property.Type, property.Name) {
+			continue
+		}
+		entry := SecretRotationEntry{ToolchainID: toolchainID, PipelineID: pipelineID, Property: *property.Name}
+		if !rotation.options.DryRun {
+			options := client.NewReplaceTektonPipelinePropertyOptions(pipelineID, *property.Name, *property.Name, PropertyTypeSecureConst)
+			options.SetValue(rotation.value)
+			options.Locked = property.Locked
+			if _, _, err = client.ReplaceTektonPipelinePropertyWithContext(ctx, options); err != nil {
+				rotation.fail(entry, err)
+				continue
+			}
+		}
+		rotation.succeed(entry)
+	}
+
+	triggers, _, err := client.ListTektonPipelineTriggersWithContext(ctx, client.NewListTektonPipelineTriggersOptions(pipelineID))
+	if err != nil {
+		rotation.fail(SecretRotationEntry{ToolchainID: toolchainID, PipelineID: pipelineID}, err)
+		return
+	}
+	for _, item := range triggers.Triggers {
+		trigger := AsTrigger(item)
+		if trigger == nil || trigger.ID == nil {
+			continue
+		}
+		base := SecretRotationEntry{ToolchainID: toolchainID, PipelineID: pipelineID, TriggerID: *trigger.ID, TriggerName: core.StringNilMapper(trigger.Name)}
+		triggerProperties, _, err := client.ListTektonPipelineTriggerPropertiesWithContext(ctx, client.NewListTektonPipelineTriggerPropertiesOptions(pipelineID, *trigger.ID))
+		if err != nil {
+			rotation.fail(base, err)
+			continue
+		}
+		for _, property := range triggerProperties.Properties {
+			if !rotation.matches(property.Type, property.Name) {
+				continue
+			}
+			entry := base
+			entry.Property = *property.Name
+			if !rotation.options.DryRun {
+				options := client.NewReplaceTektonPipelineTriggerPropertyOptions(pipelineID, *trigger.ID, *property.Name, *property.Name, TriggerPropertyTypeSecureConst)
+				options.SetValue(rotation.value)
+				options.Locked = property.Locked
+				if _, _, err = client.ReplaceTektonPipelineTriggerPropertyWithContext(ctx, options); err != nil {
+					rotation.fail(entry, err)
+					continue
+				}
+			}
+			rotation.succeed(entry)
+		}
+	}
+}
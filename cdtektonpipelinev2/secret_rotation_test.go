@@ -0,0 +1,167 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtektonpipelinev2_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync"
+
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtektonpipelinev2"
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtoolchainv2"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`RotateSecureProperties`, func() {
+	var (
+		testServer              *httptest.Server
+		cdTektonPipelineService *cdtektonpipelinev2.CdTektonPipelineV2
+		cdToolchainService      *cdtoolchainv2.CdToolchainV2
+		mutex                   sync.Mutex
+		replaced                map[string]map[string]interface{}
+	)
+
+	BeforeEach(func() {
+		replaced = map[string]map[string]interface{}{}
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+			mutex.Lock()
+			defer mutex.Unlock()
+			res.Header().Set("Content-type", "application/json")
+			path := req.URL.EscapedPath()
+			if req.Method == "PUT" {
+				var body map[string]interface{}
+				Expect(json.NewDecoder(req.Body).Decode(&body)).To(Succeed())
+				if path == "/tekton_pipelines/p1/triggers/t2/properties/api_token" {
+					res.WriteHeader(500)
+					fmt.Fprint(res, `{"errors": [{"code": "internal_error", "message": "failed"}], "status_code": 500}`)
+					return
+				}
+				replaced[path] = body
+				fmt.Fprint(res, `{}`)
+				return
+			}
+			switch path {
+			case "/toolchains":
+				Expect(req.URL.Query().Get("resource_group_id")).To(Equal("rg1"))
+				fmt.Fprint(res, `{"limit": 20, "total_count": 2, "first": {"href": "/toolchains"}, "toolchains": [{"id": "tc1"}, {"id": "tc2"}]}`)
+			case "/toolchains/tc1/tools":
+				fmt.Fprint(res, `{"limit": 20, "total_count": 3, "first": {"href": "/toolchains/tc1/tools"}, "tools": [
+					{"id": "p1", "tool_type_id": "pipeline", "parameters": {"type": "tekton"}},
+					{"id": "classic", "tool_type_id": "pipeline", "parameters": {"type": "classic"}},
+					{"id": "repo", "tool_type_id": "githubconsolidated", "parameters": {}}]}`)
+			case "/tekton_pipelines/p1/properties":
+				fmt.Fprint(res, `{"properties": [
+					{"name": "api_token", "type": "secure", "value": "hash", "locked": true},
+					{"name": "api_url", "type": "text", "value": "https://api"},
+					{"name": "db_password", "type": "secure", "value": "hash"}]}`)
+			case "/tekton_pipelines/p1/triggers":
+				fmt.Fprint(res, `{"triggers": [{"id": "t1", "name": "manual", "type": "manual"}, {"id": "t2", "name": "nightly", "type": "timer"}]}`)
+			case "/tekton_pipelines/p1/triggers/t1/properties", "/tekton_pipelines/p1/triggers/t2/properties":
+				fmt.Fprint(res, `{"properties": [{"name": "api_token", "type": "secure", "value": "hash"}]}`)
+			default:
+				res.WriteHeader(404)
+				fmt.Fprint(res, `{"errors": [{"code": "not_found", "message": "not found"}], "status_code": 404}`)
+			}
+		}))
+		var err error
+		cdTektonPipelineService, err = cdtektonpipelinev2.NewCdTektonPipelineV2(&cdtektonpipelinev2.CdTektonPipelineV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+		cdToolchainService, err = cdtoolchainv2.NewCdToolchainV2(&cdtoolchainv2.CdToolchainV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+		os.Setenv("ROTATION_TEST_TOKEN", "rotated-value")
+	})
+	AfterEach(func() {
+		testServer.Close()
+		os.Unsetenv("ROTATION_TEST_TOKEN")
+	})
+
+	It(`Invoke RotateSecureProperties across the toolchains of a resource group`, func() {
+		report, err := cdTektonPipelineService.RotateSecureProperties(&cdtektonpipelinev2.RotateSecurePropertiesOptions{
+			ToolchainClient: cdToolchainService,
+			ResourceGroupID: "rg1",
+			NamePattern:     "api_*",
+			Value:           "env:ROTATION_TEST_TOKEN",
+			SecretResolvers: cdtektonpipelinev2.SecretResolvers{"env": &cdtektonpipelinev2.EnvSecretResolver{}},
+		})
+		Expect(err).To(BeNil())
+		Expect(report.PipelinesScanned).To(Equal(1))
+		Expect(report.Entries).To(Equal([]cdtektonpipelinev2.SecretRotationEntry{
+			{ToolchainID: "tc1", PipelineID: "p1", Property: "api_token", Status: "replaced"},
+			{ToolchainID: "tc1", PipelineID: "p1", TriggerID: "t1", TriggerName: "manual", Property: "api_token", Status: "replaced"},
+			{ToolchainID: "tc1", PipelineID: "p1", TriggerID: "t2", TriggerName: "nightly", Property: "api_token", Status: "failed", Error: "failed"},
+			{ToolchainID: "tc2", Status: "failed", Error: "not found"},
+		}))
+		Expect(report.Failed()).To(HaveLen(2))
+
+		Expect(replaced).To(HaveLen(2))
+		Expect(replaced["/tekton_pipelines/p1/properties/api_token"]).To(Equal(map[string]interface{}{
+			"name": "api_token", "type": "secure", "value": "rotated-value", "locked": true,
+		}))
+		Expect(replaced["/tekton_pipelines/p1/triggers/t1/properties/api_token"]["value"]).To(Equal("rotated-value"))
+
+		var buf bytes.Buffer
+		Expect(report.WriteJSON(&buf)).To(Succeed())
+		Expect(buf.String()).To(ContainSubstring(`"property": "api_token"`))
+		Expect(buf.String()).ToNot(ContainSubstring("rotated-value"))
+	})
+	It(`Invoke RotateSecureProperties in dry-run mode`, func() {
+		report, err := cdTektonPipelineService.RotateSecureProperties(&cdtektonpipelinev2.RotateSecurePropertiesOptions{
+			ToolchainClient: cdToolchainService,
+			ToolchainIDs:    []string{"tc1"},
+			NamePattern:     "*",
+			Value:           "new-value",
+			DryRun:          true,
+		})
+		Expect(err).To(BeNil())
+		Expect(report.Entries).To(HaveLen(4))
+		for _, entry := range report.Entries {
+			Expect(entry.Status).To(Equal(cdtektonpipelinev2.SecretRotationStatusWouldReplaceConst))
+		}
+		Expect(replaced).To(BeEmpty())
+	})
+	It(`Invoke RotateSecureProperties with invalid options`, func() {
+		_, err := cdTektonPipelineService.RotateSecureProperties(nil)
+		Expect(err).ToNot(BeNil())
+		_, err = cdTektonPipelineService.RotateSecureProperties(&cdtektonpipelinev2.RotateSecurePropertiesOptions{
+			ToolchainClient: cdToolchainService, NamePattern: "*", Value: "x",
+		})
+		Expect(err).ToNot(BeNil())
+		_, err = cdTektonPipelineService.RotateSecureProperties(&cdtektonpipelinev2.RotateSecurePropertiesOptions{
+			ToolchainClient: cdToolchainService, ToolchainIDs: []string{"tc1"}, NamePattern: "[", Value: "x",
+		})
+		Expect(err).ToNot(BeNil())
+		_, err = cdTektonPipelineService.RotateSecureProperties(&cdtektonpipelinev2.RotateSecurePropertiesOptions{
+			ToolchainClient: cdToolchainService, ToolchainIDs: []string{"tc1"}, NamePattern: "*",
+			Value: "env:ROTATION_TEST_MISSING", SecretResolvers: cdtektonpipelinev2.SecretResolvers{"env": &cdtektonpipelinev2.EnvSecretResolver{}},
+		})
+		Expect(err).ToNot(BeNil())
+		Expect(replaced).To(BeEmpty())
+	})
+})
@@ -0,0 +1,120 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtektonpipelinev2_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtektonpipelinev2"
+	common "github.com/IBM/continuous-delivery-go-sdk/v2/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+type recordedSpan struct {
+	name       string
+	attributes map[string]interface{}
+}
+
+type recordingTracer struct {
+	spans []*recordedSpan
+}
+
+func (tracer *recordingTracer) Start(ctx context.Context, spanName string) (context.Context, common.Span) {
+	span := &recordedSpan{name: spanName, attributes: map[string]interface{}{}}
+	tracer.spans = append(tracer.spans, span)
+	return ctx, span
+}
+
+func (span *recordedSpan) SetAttributes(attributes ...common.Attribute) {
+	for _, attribute := range attributes {
+		span.attributes[attribute.Key] = attribute.Value
+	}
+}
+func (span *recordedSpan) RecordError(err error) {}
+func (span *recordedSpan) End()                  {}
+func (span *recordedSpan) TraceContext() common.TraceContext {
+	return common.TraceContext{TraceID: [16]byte{1}, SpanID: [8]byte{byte(len(span.name))}, Sampled: true}
+}
+
+var _ = Describe(`Client telemetry`, func() {
+	It(`Trace each operation`, func() {
+		var traceParents []string
+		testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			traceParents = append(traceParents, req.Header.Get("traceparent"))
+			res.Header().Set("Content-type", "application/json")
+			fmt.Fprint(res, `{"id": "t1", "type": "manual"}`)
+		}))
+		defer testServer.Close()
+
+		tracer := &recordingTracer{}
+		cdTektonPipelineService, err := cdtektonpipelinev2.NewCdTektonPipelineV2(&cdtektonpipelinev2.CdTektonPipelineV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+			Telemetry:     common.NewTelemetry(&common.TelemetryOptions{Tracer: tracer}),
+		})
+		Expect(err).To(BeNil())
+		clone := cdTektonPipelineService.Clone()
+
+		_, _, err = clone.GetTektonPipelineTrigger(clone.NewGetTektonPipelineTriggerOptions("p1", "t1"))
+		Expect(err).To(BeNil())
+		_, err = cdTektonPipelineService.DeleteTektonPipelineTrigger(cdTektonPipelineService.NewDeleteTektonPipelineTriggerOptions("p1", "t1"))
+		Expect(err).To(BeNil())
+
+		Expect(tracer.spans).To(HaveLen(2))
+		Expect(tracer.spans[0].name).To(Equal("GetTektonPipelineTrigger"))
+		Expect(tracer.spans[0].attributes).To(HaveKeyWithValue(common.AttributePipelineID, "p1"))
+		Expect(tracer.spans[0].attributes).To(HaveKeyWithValue(common.AttributeTriggerID, "t1"))
+		Expect(tracer.spans[0].attributes).To(HaveKeyWithValue(common.AttributeHTTPStatusCode, 200))
+		Expect(tracer.spans[1].name).To(Equal("DeleteTektonPipelineTrigger"))
+		Expect(tracer.spans[1].attributes).To(HaveKeyWithValue(common.AttributeHTTPMethod, "DELETE"))
+		Expect(traceParents).To(Equal([]string{
+			"00-01000000000000000000000000000000-1800000000000000-01",
+			"00-01000000000000000000000000000000-1b00000000000000-01",
+		}))
+	})
+	It(`Log each operation`, func() {
+		testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			res.Header().Set("Content-type", "application/json")
+			res.WriteHeader(201)
+			fmt.Fprint(res, `{"name": "token", "type": "secure", "value": "s3cr3t"}`)
+		}))
+		defer testServer.Close()
+
+		var buf bytes.Buffer
+		logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+		cdTektonPipelineService, err := cdtektonpipelinev2.NewCdTektonPipelineV2(&cdtektonpipelinev2.CdTektonPipelineV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+			Telemetry:     common.NewTelemetry(&common.TelemetryOptions{Logger: logger, LogBodies: true}),
+		})
+		Expect(err).To(BeNil())
+
+		createOptions := cdTektonPipelineService.NewCreateTektonPipelinePropertiesOptions("p1", "token", "secure")
+		_, _, err = cdTektonPipelineService.CreateTektonPipelineProperties(createOptions.SetValue("s3cr3t"))
+		Expect(err).To(BeNil())
+		Expect(buf.String()).To(ContainSubstring(`level=DEBUG msg="Request succeeded" operation=CreateTektonPipelineProperties method=POST path=/tekton_pipelines/p1/properties status=201`))
+		Expect(buf.String()).To(ContainSubstring("retries=0"))
+		Expect(buf.String()).ToNot(ContainSubstring("s3cr3t"))
+	})
+})
@@ -0,0 +1,277 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtektonpipelinev2
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtoolchainv2"
+	common "github.com/IBM/continuous-delivery-go-sdk/v2/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Constants associated with the HealthIssue.Code property.
+const (
+	// A tool is in the `misconfigured` state.
+	HealthIssueCodeToolMisconfiguredConst = "tool_misconfigured"
+	// A tool is in the `unconfigured` state.
+	HealthIssueCodeToolUnconfiguredConst = "tool_unconfigured"
+	// A Tekton pipeline has no enabled trigger, so it can only run when triggered through the API.
+	HealthIssueCodePipelineNoEnabledTriggersConst = "pipeline_no_enabled_triggers"
+	// A Git trigger listens to a repository that no tool of the toolchain integrates.
+	HealthIssueCodeTriggerRepositoryNotInToolchainConst = "trigger_repository_not_in_toolchain"
+	// The toolchain's tools or a pipeline could not be read.
+	HealthIssueCodeScanFailedConst = "scan_failed"
+)
+
+// HealthIssue : A problem found by ScanToolchainHealth.
+type HealthIssue struct {
+	// One of the HealthIssueCode constants.
+	Code string `json:"code"`
+
+	ToolID      string `json:"tool_id,omitempty"`
+	ToolName    string `json:"tool_name,omitempty"`
+	PipelineID  string `json:"pipeline_id,omitempty"`
+	TriggerID   string `json:"trigger_id,omitempty"`
+	TriggerName string `json:"trigger_name,omitempty"`
+
+	Message string `json:"message"`
+}
+
+// ToolchainHealth : The health of one toolchain.
+type ToolchainHealth struct {
+	ToolchainID string        `json:"toolchain_id"`
+	Name        string        `json:"name"`
+	Tools       int           `json:"tools"`
+	Pipelines   int           `json:"pipelines"`
+	Issues      []HealthIssue `json:"issues"`
+}
+
+// Healthy returns true if no issue was found in the toolchain.
+func (health *ToolchainHealth) Healthy() bool {
+	return len(health.Issues) == 0
+}
+
+// ToolchainHealthReport : The result of ScanToolchainHealth.
+type ToolchainHealthReport struct {
+	ResourceGroupID string            `json:"resource_group_id"`
+	ScannedAt       time.Time         `json:"scanned_at"`
+	Toolchains      []ToolchainHealth `json:"toolchains"`
+}
+
+// Healthy returns true if no issue was found in any toolchain.
+func (report *ToolchainHealthReport) Healthy() bool {
+	for i := range report.Toolchains {
+		if !report.Toolchains[i].Healthy() {
+			return false
+		}
+	}
+	return true
+}
+
+// WriteJSON renders the report as indented JSON.
+func (report *ToolchainHealthReport) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(report)
+}
+
+// WriteMarkdown renders the report as a Markdown document with a summary table and one section
+// per toolchain that has issues.
+func (report *ToolchainHealthReport) WriteMarkdown(w io.Writer) error {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# Toolchain health report\n\nResource group `%s`, scanned at %s.\n\n", report.ResourceGroupID, report.ScannedAt.UTC().Format(time.RFC3339))
+	b.WriteString("| Toolchain | Tools | Pipelines | Issues |\n|---|---|---|---|\n")
+	for _, health := range report.Toolchains {
+		fmt.Fprintf(&b, "| %s | %d | %d | %d |\n", markdownCell(health.Name), health.Tools, health.Pipelines, len(health.Issues))
+	}
+	for _, health := range report.Toolchains {
+		if health.Healthy() {
+			continue
+		}
+		fmt.Fprintf(&b, "\n## %s (`%s`)\n\n", health.Name, health.ToolchainID)
+		for _, issue := range health.Issues {
+			fmt.Fprintf(&b, "- **%s**: %s\n", issue.Code, issue.Message)
+		}
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func markdownCell(value string) string {
+	return strings.ReplaceAll(value, "|", `\|`)
+}
+
+// ScanToolchainHealthOptions : The ScanToolchainHealth options.
+type ScanToolchainHealthOptions struct {
+	// Client for the toolchain service.
+	ToolchainClient *cdtoolchainv2.CdToolchainV2
+
+	// The resource group whose toolchains are scanned.
+	ResourceGroupID string
+}
+
+// ScanToolchainHealth invokes ScanToolchainHealthWithContext using context.Background().
+func (cdTektonPipeline *CdTektonPipelineV2) ScanToolchainHealth(options *ScanToolchainHealthOptions) (report *ToolchainHealthReport, err error) {
+	report, err = cdTektonPipeline.ScanToolchainHealthWithContext(context.Background(), options)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// ScanToolchainHealthWithContext checks every toolchain of a resource group for tools that are
+// misconfigured or unconfigured, Tekton pipelines without an enabled trigger, and Git triggers
+// whose repository is not integrated in the toolchain. Failures to read a toolchain's tools or a
+// pipeline are reported as issues; an error is only returned if the toolchains cannot be listed.
+func (cdTektonPipeline *CdTektonPipelineV2) ScanToolchainHealthWithContext(ctx context.Context, options *ScanToolchainHealthOptions) (report *ToolchainHealthReport, err error) {
+	err = core.ValidateNotNil(options, "options cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	if options.ToolchainClient == nil || options.ResourceGroupID == "" {
+		err = core.SDKErrorf(nil, "the 'options.ToolchainClient' and 'options.ResourceGroupID' fields must be set", "missing-required-option", common.GetComponentInfo())
+		return
+	}
+	client := options.ToolchainClient
+	pager, err := client.NewToolchainsPager(client.NewListToolchainsOptions(options.ResourceGroupID))
+	if err != nil {
+		return
+	}
+	report = &ToolchainHealthReport{ResourceGroupID: options.ResourceGroupID, ScannedAt: time.Now(), Toolchains: []ToolchainHealth{}}
+	for pager.HasNext() {
+		var page []cdtoolchainv2.ToolchainModel
+		page, err = pager.GetNextWithContext(ctx)
+		if err != nil {
+			report = nil
+			return
+		}
+		for i := range page {
+			report.Toolchains = append(report.Toolchains, cdTektonPipeline.scanToolchain(ctx, client, &page[i]))
+		}
+	}
+	return
+}
+
+func (cdTektonPipeline *CdTektonPipelineV2) scanToolchain(ctx context.Context, client *cdtoolchainv2.CdToolchainV2, toolchain *cdtoolchainv2.ToolchainModel) (health ToolchainHealth) {
+	health = ToolchainHealth{ToolchainID: core.StringNilMapper(toolchain.ID), Name: core.StringNilMapper(toolchain.Name), Issues: []HealthIssue{}}
+	pager, err := client.NewToolsPager(client.NewListToolsOptions(health.ToolchainID))
+	var tools []cdtoolchainv2.ToolModel
+	if err == nil {
+		tools, err = pager.GetAllWithContext(ctx)
+	}
+	if err != nil {
+		health.Issues = append(health.Issues, HealthIssue{Code: HealthIssueCodeScanFailedConst, Message: "listing the tools failed: " + err.Error()})
+		return
+	}
+	health.Tools = len(tools)
+
+	repositories := map[string]bool{}
+	var pipelineIDs []string
+	for i := range tools {
+		tool := &tools[i]
+		issue := HealthIssue{ToolID: core.StringNilMapper(tool.ID), ToolName: core.StringNilMapper(tool.Name)}
+		switch core.StringNilMapper(tool.State) {
+		case cdtoolchainv2.ToolModelStateMisconfiguredConst:
+			issue.Code = HealthIssueCodeToolMisconfiguredConst
+		case cdtoolchainv2.ToolModelStateUnconfiguredConst:
+			issue.Code = HealthIssueCodeToolUnconfiguredConst
+		}
+		if issue.Code != "" {
+			issue.Message = fmt.Sprintf("tool '%s' (%s, type '%s') is %s", issue.ToolName, issue.ToolID, core.StringNilMapper(tool.ToolTypeID), core.StringNilMapper(tool.State))
+			health.Issues = append(health.Issues, issue)
+		}
+		if repoURL, ok := tool.Parameters["repo_url"].(string); ok && repoURL != "" {
+			repositories[normalizeRepositoryURL(repoURL)] = true
+		}
+		if isTektonPipelineTool(tool) {
+			pipelineIDs = append(pipelineIDs, core.StringNilMapper(tool.ID))
+		}
+	}
+
+	for _, pipelineID := range pipelineIDs {
+		pipeline, _, err := cdTektonPipeline.GetTektonPipelineWithContext(ctx, cdTektonPipeline.NewGetTektonPipelineOptions(pipelineID))
+		if err != nil {
+			health.Issues = append(health.Issues, HealthIssue{Code: HealthIssueCodeScanFailedConst, PipelineID: pipelineID, Message: fmt.Sprintf("reading pipeline '%s' failed: %s", pipelineID, err.Error())})
+			continue
+		}
+		health.Pipelines++
+		health.Issues = append(health.Issues, pipelineHealthIssues(pipeline, repositories)...)
+	}
+	return
+}
+
+// pipelineHealthIssues checks the triggers of a pipeline. repositories holds the normalized URLs
+// of the toolchain's repository tools.
+func pipelineHealthIssues(pipeline *TektonPipeline, repositories map[string]bool) (issues []HealthIssue) {
+	pipelineID := core.StringNilMapper(pipeline.ID)
+	enabled := 0
+	for _, item := range pipeline.Triggers {
+		trigger := AsTrigger(item)
+		if trigger == nil {
+			continue
+		}
+		if trigger.Enabled == nil || *trigger.Enabled {
+			enabled++
+		}
+		if trigger.Source == nil || trigger.Source.Properties == nil || trigger.Source.Properties.URL == nil {
+			continue
+		}
+		url := *trigger.Source.Properties.URL
+		if !repositories[normalizeRepositoryURL(url)] {
+			issues = append(issues, HealthIssue{
+				Code:        HealthIssueCodeTriggerRepositoryNotInToolchainConst,
+				PipelineID:  pipelineID,
+				TriggerID:   core.StringNilMapper(trigger.ID),
+				TriggerName: core.StringNilMapper(trigger.Name),
+				Message:     fmt.Sprintf("trigger '%s' of pipeline '%s' listens to '%s', which no repository tool of the toolchain integrates", core.StringNilMapper(trigger.Name), core.StringNilMapper(pipeline.Name), url),
+			})
+		}
+	}
+	if enabled == 0 {
+		issues = append([]HealthIssue{{
+			Code:       HealthIssueCodePipelineNoEnabledTriggersConst,
+			PipelineID: pipelineID,
+			Message:    fmt.Sprintf("pipeline '%s' (%s) has no enabled trigger", core.StringNilMapper(pipeline.Name), pipelineID),
+		}}, issues...)
+	}
+	return
+}
+
+// normalizeRepositoryURL makes equivalent repository URLs comparable.
+func normalizeRepositoryURL(url string) string {
+	url = strings.ToLower(strings.TrimSpace(url))
+	url = strings.TrimSuffix(strings.TrimSuffix(url, "/"), ".git")
+	return strings.TrimPrefix(strings.TrimPrefix(url, "https://"), "http://")
+}
+
+// pipelineToolTypeID is the tool type of the toolchain tools that represent delivery pipelines.
+const pipelineToolTypeID = cdtoolchainv2.ToolTypeIDPipelineConst
+
+// isTektonPipelineTool returns true for the toolchain tools that represent Tekton pipelines.
+// The ID of such a tool is also the ID of its pipeline.
+func isTektonPipelineTool(tool *cdtoolchainv2.ToolModel) bool {
+	if core.StringNilMapper(tool.ToolTypeID) != pipelineToolTypeID {
+		return false
+	}
+	pipelineType, ok := tool.Parameters["type"].(string)
+	return !ok || pipelineType == cdtoolchainv2.PipelineToolParametersTypeTektonConst
+}
@@ -0,0 +1,135 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtektonpipelinev2_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtektonpipelinev2"
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtoolchainv2"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`ScanToolchainHealth`, func() {
+	var (
+		testServer              *httptest.Server
+		cdTektonPipelineService *cdtektonpipelinev2.CdTektonPipelineV2
+		cdToolchainService      *cdtoolchainv2.CdToolchainV2
+	)
+
+	BeforeEach(func() {
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+			Expect(req.Method).To(Equal("GET"))
+			res.Header().Set("Content-type", "application/json")
+			switch req.URL.EscapedPath() {
+			case "/toolchains":
+				fmt.Fprint(res, `{"limit": 20, "total_count": 2, "first": {"href": "/toolchains"}, "toolchains": [
+					{"id": "tc1", "name": "app | prod"}, {"id": "tc2", "name": "broken"}]}`)
+			case "/toolchains/tc1/tools":
+				fmt.Fprint(res, `{"limit": 20, "total_count": 5, "first": {"href": "/toolchains/tc1/tools"}, "tools": [
+					{"id": "repo", "name": "app-repo", "tool_type_id": "githubconsolidated", "state": "configured", "parameters": {"repo_url": "https://github.com/org/app"}},
+					{"id": "slack", "name": "chat", "tool_type_id": "slack", "state": "misconfigured", "parameters": {}},
+					{"id": "p1", "name": "ci", "tool_type_id": "pipeline", "state": "configured", "parameters": {"type": "tekton"}},
+					{"id": "p2", "name": "cd", "tool_type_id": "pipeline", "state": "unconfigured", "parameters": {"type": "tekton"}},
+					{"id": "classic", "name": "old", "tool_type_id": "pipeline", "state": "configured", "parameters": {"type": "classic"}}]}`)
+			case "/tekton_pipelines/p1":
+				fmt.Fprint(res, `{"id": "p1", "name": "ci", "triggers": [
+					{"id": "t1", "name": "manual", "type": "manual", "enabled": false},
+					{"id": "t2", "name": "push", "type": "scm", "enabled": false, "source": {"type": "git", "properties": {"url": "https://github.com/org/App.git"}}},
+					{"id": "t3", "name": "other", "type": "scm", "enabled": false, "source": {"type": "git", "properties": {"url": "https://github.com/org/other"}}}]}`)
+			case "/toolchains/tc2/tools":
+				res.WriteHeader(500)
+				fmt.Fprint(res, `{"errors": [{"code": "internal_error", "message": "unavailable"}], "status_code": 500}`)
+			default:
+				res.WriteHeader(404)
+				fmt.Fprint(res, `{"errors": [{"code": "not_found", "message": "not found"}], "status_code": 404}`)
+			}
+		}))
+		var err error
+		cdTektonPipelineService, err = cdtektonpipelinev2.NewCdTektonPipelineV2(&cdtektonpipelinev2.CdTektonPipelineV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+		cdToolchainService, err = cdtoolchainv2.NewCdToolchainV2(&cdtoolchainv2.CdToolchainV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Invoke ScanToolchainHealth`, func() {
+		report, err := cdTektonPipelineService.ScanToolchainHealth(&cdtektonpipelinev2.ScanToolchainHealthOptions{
+			ToolchainClient: cdToolchainService,
+			ResourceGroupID: "rg1",
+		})
+		Expect(err).To(BeNil())
+		Expect(report.Healthy()).To(BeFalse())
+		Expect(report.Toolchains).To(HaveLen(2))
+
+		tc1 := report.Toolchains[0]
+		Expect(tc1.Tools).To(Equal(5))
+		Expect(tc1.Pipelines).To(Equal(1))
+		codes := []string{}
+		for _, issue := range tc1.Issues {
+			codes = append(codes, issue.Code)
+		}
+		Expect(codes).To(Equal([]string{
+			cdtektonpipelinev2.HealthIssueCodeToolMisconfiguredConst,
+			cdtektonpipelinev2.HealthIssueCodeToolUnconfiguredConst,
+			cdtektonpipelinev2.HealthIssueCodePipelineNoEnabledTriggersConst,
+			cdtektonpipelinev2.HealthIssueCodeTriggerRepositoryNotInToolchainConst,
+			cdtektonpipelinev2.HealthIssueCodeScanFailedConst,
+		}))
+		Expect(tc1.Issues[0].ToolID).To(Equal("slack"))
+		Expect(tc1.Issues[3].TriggerID).To(Equal("t3"))
+		Expect(tc1.Issues[3].Message).To(ContainSubstring("https://github.com/org/other"))
+		Expect(tc1.Issues[4].PipelineID).To(Equal("p2"))
+
+		tc2 := report.Toolchains[1]
+		Expect(tc2.Issues).To(HaveLen(1))
+		Expect(tc2.Issues[0].Code).To(Equal(cdtektonpipelinev2.HealthIssueCodeScanFailedConst))
+
+		var buf bytes.Buffer
+		Expect(report.WriteJSON(&buf)).To(Succeed())
+		var decoded map[string]interface{}
+		Expect(json.Unmarshal(buf.Bytes(), &decoded)).To(Succeed())
+		Expect(decoded["resource_group_id"]).To(Equal("rg1"))
+
+		buf.Reset()
+		Expect(report.WriteMarkdown(&buf)).To(Succeed())
+		Expect(buf.String()).To(ContainSubstring("| app \\| prod | 5 | 1 | 5 |"))
+		Expect(buf.String()).To(ContainSubstring("## broken (`tc2`)"))
+		Expect(buf.String()).To(ContainSubstring("- **tool_misconfigured**: tool 'chat' (slack, type 'slack') is misconfigured"))
+	})
+	It(`Invoke ScanToolchainHealth with invalid options`, func() {
+		_, err := cdTektonPipelineService.ScanToolchainHealth(nil)
+		Expect(err).ToNot(BeNil())
+		_, err = cdTektonPipelineService.ScanToolchainHealth(&cdtektonpipelinev2.ScanToolchainHealthOptions{ToolchainClient: cdToolchainService})
+		Expect(err).ToNot(BeNil())
+	})
+})
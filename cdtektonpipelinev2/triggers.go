@@ -0,0 +1,55 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtektonpipelinev2
+
+import (
+	"encoding/json"
+)
+
+// AsTrigger returns the generic Trigger view of a TriggerIntf value, regardless of which
+// trigger model it holds. It returns nil if the value cannot be represented as a Trigger.
+func AsTrigger(trigger TriggerIntf) *Trigger {
+	switch t := trigger.(type) {
+	case nil:
+		return nil
+	case *Trigger:
+		return t
+	default:
+		// The trigger variants share their JSON representation with Trigger.
+		b, err := json.Marshal(t)
+		if err != nil {
+			return nil
+		}
+		obj := new(Trigger)
+		if err = json.Unmarshal(b, obj); err != nil {
+			return nil
+		}
+		return obj
+	}
+}
+
+// hasAnyTag returns true if at least one of the tags is present in candidates.
+func hasAnyTag(tags []string, candidates []string) bool {
+	for _, tag := range tags {
+		for _, candidate := range candidates {
+			if tag == candidate {
+				return true
+			}
+		}
+	}
+	return false
+}
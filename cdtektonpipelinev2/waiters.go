@@ -0,0 +1,160 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtektonpipelinev2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	common "github.com/IBM/continuous-delivery-go-sdk/v2/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// WaitForTektonPipelineConfiguredOptions : The WaitForTektonPipelineConfigured options.
+type WaitForTektonPipelineConfiguredOptions struct {
+	// The Tekton pipeline ID.
+	PipelineID string
+
+	// The time between two GetTektonPipeline calls. Defaults to common.DefaultWaitInterval.
+	PollInterval time.Duration
+
+	// The maximum time to wait. Defaults to common.DefaultWaitTimeout.
+	Timeout time.Duration
+}
+
+// WaitForTektonPipelineConfigured invokes WaitForTektonPipelineConfiguredWithContext using context.Background().
+func (cdTektonPipeline *CdTektonPipelineV2) WaitForTektonPipelineConfigured(options *WaitForTektonPipelineConfiguredOptions) (pipeline *TektonPipeline, err error) {
+	pipeline, err = cdTektonPipeline.WaitForTektonPipelineConfiguredWithContext(context.Background(), options)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// WaitForTektonPipelineConfiguredWithContext polls GetTektonPipeline, bypassing the ResponseCache of
+// the service instance, while the pipeline is in the `configuring` state, and returns the pipeline
+// once it is `configured`. A pipeline that reports any other status is returned together with an
+// error.
+func (cdTektonPipeline *CdTektonPipelineV2) WaitForTektonPipelineConfiguredWithContext(ctx context.Context, options *WaitForTektonPipelineConfiguredOptions) (pipeline *TektonPipeline, err error) {
+	err = core.ValidateNotNil(options, "options cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	if options.PipelineID == "" {
+		err = core.SDKErrorf(nil, "the 'options.PipelineID' field must be set", "missing-pipeline-id", common.GetComponentInfo())
+		return
+	}
+	interval, timeout := options.PollInterval, options.Timeout
+	if interval <= 0 {
+		interval = common.DefaultWaitInterval
+	}
+	if timeout <= 0 {
+		timeout = common.DefaultWaitTimeout
+	}
+	waitCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	getTektonPipelineOptions := cdTektonPipeline.NewGetTektonPipelineOptions(options.PipelineID)
+	err = common.PollUntil(waitCtx, interval, func(ctx context.Context) (done bool, err error) {
+		pipeline, _, err = cdTektonPipeline.GetTektonPipelineWithContext(common.WithoutResponseCache(ctx), getTektonPipelineOptions)
+		if err != nil {
+			return
+		}
+		return core.StringNilMapper(pipeline.Status) != TektonPipelineStatusConfiguringConst, nil
+	})
+	switch {
+	case errors.Is(err, context.DeadlineExceeded) && ctx.Err() == nil:
+		err = core.SDKErrorf(err, fmt.Sprintf("timed out after %s waiting for pipeline '%s' to leave the '%s' status", timeout, options.PipelineID, TektonPipelineStatusConfiguringConst), "wait-timeout", common.GetComponentInfo())
+	case err != nil:
+		err = core.SDKErrorf(err, "", "wait-error", common.GetComponentInfo())
+	case core.StringNilMapper(pipeline.Status) != TektonPipelineStatusConfiguredConst:
+		err = core.SDKErrorf(nil, fmt.Sprintf("pipeline '%s' (%s) reports the unexpected status '%s'", core.StringNilMapper(pipeline.Name), options.PipelineID, core.StringNilMapper(pipeline.Status)), "pipeline-not-configured", common.GetComponentInfo())
+	}
+	return
+}
+
+// IsTerminalPipelineRunStatus reports whether a pipeline run in the given status has finished.
+func IsTerminalPipelineRunStatus(status string) bool {
+	switch status {
+	case PipelineRunStatusSucceededConst, PipelineRunStatusFailedConst, PipelineRunStatusCancelledConst, PipelineRunStatusErrorConst:
+		return true
+	}
+	return false
+}
+
+// WaitForTektonPipelineRunOptions : The WaitForTektonPipelineRun options.
+type WaitForTektonPipelineRunOptions struct {
+	// The Tekton pipeline ID.
+	PipelineID string
+
+	// The pipeline run ID.
+	ID string
+
+	// The time between two GetTektonPipelineRun calls. Defaults to common.DefaultWaitInterval.
+	PollInterval time.Duration
+
+	// The maximum time to wait. Defaults to common.DefaultWaitTimeout.
+	Timeout time.Duration
+}
+
+// WaitForTektonPipelineRun invokes WaitForTektonPipelineRunWithContext using context.Background().
+func (cdTektonPipeline *CdTektonPipelineV2) WaitForTektonPipelineRun(options *WaitForTektonPipelineRunOptions) (pipelineRun *PipelineRun, err error) {
+	pipelineRun, err = cdTektonPipeline.WaitForTektonPipelineRunWithContext(context.Background(), options)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// WaitForTektonPipelineRunWithContext polls GetTektonPipelineRun until the run reaches a terminal
+// status (see IsTerminalPipelineRunStatus), and returns the finished run. A failed run is not an
+// error; callers inspect the returned status.
+func (cdTektonPipeline *CdTektonPipelineV2) WaitForTektonPipelineRunWithContext(ctx context.Context, options *WaitForTektonPipelineRunOptions) (pipelineRun *PipelineRun, err error) {
+	err = core.ValidateNotNil(options, "options cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	if options.PipelineID == "" || options.ID == "" {
+		err = core.SDKErrorf(nil, "the 'options.PipelineID' and 'options.ID' fields must be set", "missing-run-id", common.GetComponentInfo())
+		return
+	}
+	interval, timeout := options.PollInterval, options.Timeout
+	if interval <= 0 {
+		interval = common.DefaultWaitInterval
+	}
+	if timeout <= 0 {
+		timeout = common.DefaultWaitTimeout
+	}
+	waitCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	getTektonPipelineRunOptions := cdTektonPipeline.NewGetTektonPipelineRunOptions(options.PipelineID, options.ID)
+	err = common.PollUntil(waitCtx, interval, func(ctx context.Context) (done bool, err error) {
+		pipelineRun, _, err = cdTektonPipeline.GetTektonPipelineRunWithContext(ctx, getTektonPipelineRunOptions)
+		if err != nil {
+			return
+		}
+		return IsTerminalPipelineRunStatus(core.StringNilMapper(pipelineRun.Status)), nil
+	})
+	switch {
+	case errors.Is(err, context.DeadlineExceeded) && ctx.Err() == nil:
+		err = core.SDKErrorf(err, fmt.Sprintf("timed out after %s waiting for pipeline run '%s' to finish", timeout, options.ID), "wait-timeout", common.GetComponentInfo())
+	case err != nil:
+		err = core.SDKErrorf(err, "", "wait-error", common.GetComponentInfo())
+	}
+	return
+}
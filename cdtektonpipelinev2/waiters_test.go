@@ -0,0 +1,101 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtektonpipelinev2_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"time"
+
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtektonpipelinev2"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`WaitForTektonPipelineConfigured`, func() {
+	var (
+		testServer              *httptest.Server
+		cdTektonPipelineService *cdtektonpipelinev2.CdTektonPipelineV2
+		calls                   int32
+		statuses                []string
+	)
+
+	BeforeEach(func() {
+		atomic.StoreInt32(&calls, 0)
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+			Expect(req.URL.EscapedPath()).To(Equal("/tekton_pipelines/p1"))
+			call := int(atomic.AddInt32(&calls, 1)) - 1
+			status := statuses[len(statuses)-1]
+			if call < len(statuses) {
+				status = statuses[call]
+			}
+			res.Header().Set("Content-type", "application/json")
+			fmt.Fprintf(res, `{"id": "p1", "name": "ci", "status": "%s"}`, status)
+		}))
+		var err error
+		cdTektonPipelineService, err = cdtektonpipelinev2.NewCdTektonPipelineV2(&cdtektonpipelinev2.CdTektonPipelineV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Wait until the pipeline is configured`, func() {
+		statuses = []string{"configuring", "configured"}
+		pipeline, err := cdTektonPipelineService.WaitForTektonPipelineConfigured(&cdtektonpipelinev2.WaitForTektonPipelineConfiguredOptions{
+			PipelineID: "p1", PollInterval: time.Millisecond,
+		})
+		Expect(err).To(BeNil())
+		Expect(*pipeline.Status).To(Equal("configured"))
+		Expect(atomic.LoadInt32(&calls)).To(Equal(int32(2)))
+	})
+	It(`Report an unexpected status`, func() {
+		statuses = []string{"broken"}
+		pipeline, err := cdTektonPipelineService.WaitForTektonPipelineConfigured(&cdtektonpipelinev2.WaitForTektonPipelineConfiguredOptions{PipelineID: "p1"})
+		Expect(err).ToNot(BeNil())
+		Expect(*pipeline.Status).To(Equal("broken"))
+		Expect(err.Error()).To(ContainSubstring("unexpected status 'broken'"))
+	})
+	It(`Stop waiting when the context is canceled or the timeout expires`, func() {
+		statuses = []string{"configuring"}
+		_, err := cdTektonPipelineService.WaitForTektonPipelineConfigured(&cdtektonpipelinev2.WaitForTektonPipelineConfiguredOptions{
+			PipelineID: "p1", PollInterval: time.Millisecond, Timeout: 20 * time.Millisecond,
+		})
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("timed out"))
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, err = cdTektonPipelineService.WaitForTektonPipelineConfiguredWithContext(ctx, &cdtektonpipelinev2.WaitForTektonPipelineConfiguredOptions{PipelineID: "p1"})
+		Expect(errors.Is(err, context.Canceled)).To(BeTrue())
+	})
+	It(`Invoke WaitForTektonPipelineConfigured with invalid options`, func() {
+		_, err := cdTektonPipelineService.WaitForTektonPipelineConfigured(nil)
+		Expect(err).ToNot(BeNil())
+		_, err = cdTektonPipelineService.WaitForTektonPipelineConfigured(&cdtektonpipelinev2.WaitForTektonPipelineConfiguredOptions{})
+		Expect(err).ToNot(BeNil())
+	})
+})
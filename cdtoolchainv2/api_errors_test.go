@@ -0,0 +1,102 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtoolchainv2_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtoolchainv2"
+	common "github.com/IBM/continuous-delivery-go-sdk/v2/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Typed API errors`, func() {
+	var (
+		testServer         *httptest.Server
+		cdToolchainService *cdtoolchainv2.CdToolchainV2
+	)
+
+	BeforeEach(func() {
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			res.Header().Set("Content-type", "application/json")
+			switch req.URL.Path {
+			case "/toolchains/missing":
+				res.WriteHeader(404)
+				fmt.Fprint(res, `{"status_code": 404, "trace": "t404", "errors": [{"code": "not_found", "message": "Toolchain not found"}]}`)
+			case "/toolchains":
+				res.WriteHeader(400)
+				fmt.Fprint(res, `{"status_code": 400, "trace": "t400", "errors": [{"code": "invalid_name", "message": "The name is invalid", "target": {"name": "name", "type": "field"}}]}`)
+			case "/toolchains/tc1/tools":
+				res.WriteHeader(409)
+				fmt.Fprint(res, `{"errors": [{"code": "conflict", "message": "A tool with this name exists"}]}`)
+			default:
+				res.Header().Set("Retry-After", "2")
+				res.WriteHeader(429)
+				fmt.Fprint(res, `{"errors": [{"code": "too_many_requests", "message": "Rate limit exceeded"}]}`)
+			}
+		}))
+		var err error
+		cdToolchainService, err = cdtoolchainv2.NewCdToolchainV2(&cdtoolchainv2.CdToolchainV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Classify error responses`, func() {
+		_, _, err := cdToolchainService.GetToolchainByID(cdToolchainService.NewGetToolchainByIDOptions("missing"))
+		Expect(errors.Is(err, common.ErrNotFound)).To(BeTrue())
+		Expect(errors.Is(err, common.ErrConflict)).To(BeFalse())
+		Expect(err.Error()).To(Equal("Toolchain not found"))
+		var apiError *common.APIError
+		Expect(errors.As(err, &apiError)).To(BeTrue())
+		Expect(apiError.StatusCode).To(Equal(404))
+		Expect(apiError.Trace).To(Equal("t404"))
+		var httpProblem *core.HTTPProblem
+		Expect(errors.As(err, &httpProblem)).To(BeTrue())
+		Expect(httpProblem.OperationID).To(Equal("get_toolchain_by_id"))
+		var sdkProblem *core.SDKProblem
+		Expect(errors.As(err, &sdkProblem)).To(BeTrue())
+		Expect(sdkProblem.Function).To(HaveSuffix("cdtoolchainv2.(*CdToolchainV2).GetToolchainByID"))
+		Expect(sdkProblem.GetDebugMessage()).To(ContainSubstring("core_problem"))
+		var coreProblem *core.SDKProblem
+		Expect(errors.As(apiError, &coreProblem)).To(BeTrue())
+		Expect(coreProblem.Component.Name).To(Equal(core.MODULE_NAME))
+
+		_, _, err = cdToolchainService.CreateToolchain(cdToolchainService.NewCreateToolchainOptions("bad name", "rg1"))
+		Expect(errors.Is(err, common.ErrValidation)).To(BeTrue())
+		Expect(errors.As(err, &apiError)).To(BeTrue())
+		Expect(apiError.Fields).To(Equal([]common.FieldError{{Field: "name", Code: "invalid_name", Message: "The name is invalid"}}))
+
+		_, _, err = cdToolchainService.CreateTool(cdToolchainService.NewCreateToolOptions("tc1", "slack"))
+		Expect(errors.Is(err, common.ErrConflict)).To(BeTrue())
+
+		_, err = cdToolchainService.DeleteTool(cdToolchainService.NewDeleteToolOptions("tc1", "t1"))
+		Expect(errors.Is(err, common.ErrRateLimited)).To(BeTrue())
+		Expect(errors.As(err, &apiError)).To(BeTrue())
+		Expect(apiError.RetryAfter).To(Equal(2 * time.Second))
+	})
+})
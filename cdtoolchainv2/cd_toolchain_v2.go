@@ -49,6 +49,15 @@ type CdToolchainV2Options struct {
 	ServiceName   string
 	URL           string
 	Authenticator core.Authenticator
+
+	// Limits the rate and concurrency of the requests sent by the service instance and its clones.
+	RateLimiter *common.RateLimiter
+
+	// Caches the responses of GetToolchainByID and GetToolByID for the service instance and its clones.
+	ResponseCache *common.ResponseCache
+
+	// Traces, measures and logs the operations of the service instance and its clones.
+	Telemetry *common.Telemetry
 }
 
 // NewCdToolchainV2UsingExternalConfig : constructs an instance of CdToolchainV2 with passed in options and external configuration.
@@ -71,7 +80,7 @@ func NewCdToolchainV2UsingExternalConfig(options *CdToolchainV2Options) (cdToolc
 		return
 	}
 
-	err = cdToolchain.Service.ConfigureService(options.ServiceName)
+	err = common.ConfigureService(cdToolchain.Service, options.ServiceName)
 	if err != nil {
 		err = core.SDKErrorf(err, "", "client-config-error", common.GetComponentInfo())
 		return
@@ -109,6 +118,16 @@ func NewCdToolchainV2(options *CdToolchainV2Options) (service *CdToolchainV2, er
 		Service: baseService,
 	}
 
+	if options.RateLimiter != nil {
+		common.EnableRateLimiting(service.Service, options.RateLimiter)
+	}
+	if options.ResponseCache != nil {
+		common.EnableResponseCaching(service.Service, options.ResponseCache, isCacheablePath)
+	}
+	if options.Telemetry != nil {
+		common.EnableTelemetry(service.Service, options.Telemetry)
+	}
+
 	return
 }
 
@@ -183,6 +202,20 @@ func (cdToolchain *CdToolchainV2) DisableRetries() {
 	cdToolchain.Service.DisableRetries()
 }
 
+// DisableSSLVerification skips the verification of server certificates and hostnames for this
+// service instance. Unlike Service.DisableSSLVerification, it also takes effect after features
+// such as debug logging, or the RateLimiter and ResponseCache options, have wrapped the transport
+// of the HTTP client. Service.DisableSSLVerification and Service.IsSSLDisabled have no effect on
+// a wrapped transport.
+func (cdToolchain *CdToolchainV2) DisableSSLVerification() {
+	common.DisableSSLVerification(cdToolchain.Service)
+}
+
+// IsSSLDisabled returns true if this service instance skips the verification of server certificates.
+func (cdToolchain *CdToolchainV2) IsSSLDisabled() bool {
+	return common.IsSSLDisabled(cdToolchain.Service)
+}
+
 // ListToolchains : Get a list of toolchains
 // Returns a list of toolchains that the caller is authorized to access and that meets the provided query parameters.
 func (cdToolchain *CdToolchainV2) ListToolchains(listToolchainsOptions *ListToolchainsOptions) (result *ToolchainCollection, response *core.DetailedResponse, err error) {
@@ -244,7 +277,7 @@ func (cdToolchain *CdToolchainV2) ListToolchainsWithContext(ctx context.Context,
 	response, err = cdToolchain.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_toolchains", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = common.ClassifyError(core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo()))
 		return
 	}
 	if rawResponse != nil {
@@ -326,7 +359,7 @@ func (cdToolchain *CdToolchainV2) CreateToolchainWithContext(ctx context.Context
 	response, err = cdToolchain.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_toolchain", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = common.ClassifyError(core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo()))
 		return
 	}
 	if rawResponse != nil {
@@ -395,7 +428,7 @@ func (cdToolchain *CdToolchainV2) GetToolchainByIDWithContext(ctx context.Contex
 	response, err = cdToolchain.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_toolchain_by_id", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = common.ClassifyError(core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo()))
 		return
 	}
 	if rawResponse != nil {
@@ -462,7 +495,7 @@ func (cdToolchain *CdToolchainV2) DeleteToolchainWithContext(ctx context.Context
 	response, err = cdToolchain.Service.Request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_toolchain", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = common.ClassifyError(core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo()))
 		return
 	}
 
@@ -530,7 +563,7 @@ func (cdToolchain *CdToolchainV2) UpdateToolchainWithContext(ctx context.Context
 	response, err = cdToolchain.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "update_toolchain", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = common.ClassifyError(core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo()))
 		return
 	}
 	if rawResponse != nil {
@@ -620,7 +653,7 @@ func (cdToolchain *CdToolchainV2) CreateToolchainEventWithContext(ctx context.Co
 	response, err = cdToolchain.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_toolchain_event", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = common.ClassifyError(core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo()))
 		return
 	}
 	if rawResponse != nil {
@@ -697,7 +730,7 @@ func (cdToolchain *CdToolchainV2) ListToolsWithContext(ctx context.Context, list
 	response, err = cdToolchain.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_tools", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = common.ClassifyError(core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo()))
 		return
 	}
 	if rawResponse != nil {
@@ -783,7 +816,7 @@ func (cdToolchain *CdToolchainV2) CreateToolWithContext(ctx context.Context, cre
 	response, err = cdToolchain.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_tool", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = common.ClassifyError(core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo()))
 		return
 	}
 	if rawResponse != nil {
@@ -853,7 +886,7 @@ func (cdToolchain *CdToolchainV2) GetToolByIDWithContext(ctx context.Context, ge
 	response, err = cdToolchain.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_tool_by_id", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = common.ClassifyError(core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo()))
 		return
 	}
 	if rawResponse != nil {
@@ -921,7 +954,7 @@ func (cdToolchain *CdToolchainV2) DeleteToolWithContext(ctx context.Context, del
 	response, err = cdToolchain.Service.Request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_tool", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = common.ClassifyError(core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo()))
 		return
 	}
 
@@ -990,7 +1023,7 @@ func (cdToolchain *CdToolchainV2) UpdateToolWithContext(ctx context.Context, upd
 	response, err = cdToolchain.Service.Request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "update_tool", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = common.ClassifyError(core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo()))
 		return
 	}
 	if rawResponse != nil {
@@ -0,0 +1,351 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtoolchainv2
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	common "github.com/IBM/continuous-delivery-go-sdk/v2/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Defaults of ToolchainEventPublisherOptions.
+const (
+	DefaultEventPublisherQueueSize        = 1000
+	DefaultEventPublisherWorkers          = 4
+	DefaultEventPublisherRate             = 10
+	DefaultEventPublisherMaxRetries       = 5
+	DefaultEventPublisherRetryInterval    = time.Second
+	DefaultEventPublisherMaxRetryInterval = 30 * time.Second
+)
+
+// ToolchainEventPublisherOptions : The NewToolchainEventPublisher options. Zero values select the
+// defaults.
+type ToolchainEventPublisherOptions struct {
+	// The number of events that can wait for delivery. Publish blocks while the queue is full.
+	QueueSize int
+
+	// The number of events sent concurrently.
+	Workers int
+
+	// The maximum number of CreateToolchainEvent calls per second, shared by all workers.
+	Rate float64
+
+	// The number of times a delivery failing with status 429 or 5xx is retried. As with the other
+	// options, zero selects the default; a negative value disables retries.
+	MaxRetries int
+
+	// The delay before the first retry, doubled for each further retry up to MaxRetryInterval.
+	// A Retry-After header in the failed response takes precedence.
+	RetryInterval time.Duration
+
+	// The maximum delay between two attempts. It does not apply to the delay requested by a
+	// Retry-After header.
+	MaxRetryInterval time.Duration
+
+	// Called from a worker goroutine once per published event, when it was delivered or when
+	// delivery was abandoned, including when CloseWithContext abandons it. A panic in the callback
+	// is recovered and logged with the core library's logger.
+	OnDelivery func(delivery *ToolchainEventDelivery)
+}
+
+// ToolchainEventDelivery : The outcome of the delivery of an event.
+type ToolchainEventDelivery struct {
+	// The toolchain the event was published to.
+	ToolchainID string
+
+	// The event.
+	Event *ToolchainEvent
+
+	// The response of the service, if the event was delivered.
+	Result *ToolchainEventPost
+
+	// The number of CreateToolchainEvent calls made.
+	Attempts int
+
+	// The error of the last attempt, if the event was not delivered.
+	Err error
+}
+
+// ToolchainEventPublisher : Delivers toolchain events asynchronously, from an internal queue, at
+// a limited rate. Use NewToolchainEventPublisher to create one, and Close to release it.
+type ToolchainEventPublisher struct {
+	cdToolchain *CdToolchainV2
+	options     ToolchainEventPublisherOptions
+	queue       chan *ToolchainEventDelivery
+	stop        chan struct{}
+	workers     sync.WaitGroup
+
+	// ctx is cancelled by CloseWithContext to abandon the deliveries in progress.
+	ctx    context.Context
+	cancel context.CancelCauseFunc
+
+	// mutex guards the fields below.
+	mutex   sync.Mutex
+	closed  bool
+	pending int
+	idle    chan struct{}
+	next    time.Time
+}
+
+// NewToolchainEventPublisher starts a publisher that sends events with this service instance.
+func (cdToolchain *CdToolchainV2) NewToolchainEventPublisher(options *ToolchainEventPublisherOptions) *ToolchainEventPublisher {
+	publisher := &ToolchainEventPublisher{cdToolchain: cdToolchain, stop: make(chan struct{}), idle: make(chan struct{})}
+	if options != nil {
+		publisher.options = *options
+	}
+	close(publisher.idle)
+	publisher.ctx, publisher.cancel = context.WithCancelCause(context.Background())
+	if publisher.options.QueueSize <= 0 {
+		publisher.options.QueueSize = DefaultEventPublisherQueueSize
+	}
+	if publisher.options.Workers <= 0 {
+		publisher.options.Workers = DefaultEventPublisherWorkers
+	}
+	if publisher.options.Rate <= 0 {
+		publisher.options.Rate = DefaultEventPublisherRate
+	}
+	if publisher.options.MaxRetries < 0 {
+		publisher.options.MaxRetries = 0
+	} else if publisher.options.MaxRetries == 0 {
+		publisher.options.MaxRetries = DefaultEventPublisherMaxRetries
+	}
+	if publisher.options.RetryInterval <= 0 {
+		publisher.options.RetryInterval = DefaultEventPublisherRetryInterval
+	}
+	if publisher.options.MaxRetryInterval <= 0 {
+		publisher.options.MaxRetryInterval = DefaultEventPublisherMaxRetryInterval
+	}
+	publisher.queue = make(chan *ToolchainEventDelivery, publisher.options.QueueSize)
+	for i := 0; i < publisher.options.Workers; i++ {
+		publisher.workers.Add(1)
+		go publisher.work()
+	}
+	return publisher
+}
+
+// Publish invokes PublishWithContext using context.Background().
+func (publisher *ToolchainEventPublisher) Publish(toolchainID string, event *ToolchainEvent) (err error) {
+	err = publisher.PublishWithContext(context.Background(), toolchainID, event)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// PublishWithContext validates an event and queues it for delivery, waiting for room in the queue
+// until ctx is done. The outcome of the delivery is reported to the OnDelivery callback.
+func (publisher *ToolchainEventPublisher) PublishWithContext(ctx context.Context, toolchainID string, event *ToolchainEvent) (err error) {
+	if toolchainID == "" {
+		err = core.SDKErrorf(nil, "toolchainID must be set", "missing-toolchain-id", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateNotNil(event, "event cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = event.Validate()
+	if err != nil {
+		return
+	}
+
+	publisher.mutex.Lock()
+	if publisher.closed {
+		publisher.mutex.Unlock()
+		err = core.SDKErrorf(nil, "the event publisher is closed", "publisher-closed", common.GetComponentInfo())
+		return
+	}
+	if publisher.pending == 0 {
+		publisher.idle = make(chan struct{})
+	}
+	publisher.pending++
+	publisher.mutex.Unlock()
+
+	select {
+	case publisher.queue <- &ToolchainEventDelivery{ToolchainID: toolchainID, Event: event}:
+	case <-ctx.Done():
+		publisher.done()
+		err = core.SDKErrorf(ctx.Err(), "", "publish-canceled", common.GetComponentInfo())
+	}
+	return
+}
+
+// Flush waits until every event published so far was delivered or abandoned, or until ctx is done.
+func (publisher *ToolchainEventPublisher) Flush(ctx context.Context) error {
+	publisher.mutex.Lock()
+	idle := publisher.idle
+	publisher.mutex.Unlock()
+	select {
+	case <-idle:
+		return nil
+	case <-ctx.Done():
+		return core.SDKErrorf(ctx.Err(), "", "flush-canceled", common.GetComponentInfo())
+	}
+}
+
+// Close invokes CloseWithContext using context.Background().
+func (publisher *ToolchainEventPublisher) Close() (err error) {
+	err = publisher.CloseWithContext(context.Background())
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// CloseWithContext stops accepting events, waits until the queued events are delivered or
+// abandoned, and stops the workers. If ctx is done first, the deliveries in progress are
+// interrupted and the remaining events are abandoned: each is reported to the OnDelivery callback
+// with the error of ctx, and the error of ctx is returned.
+func (publisher *ToolchainEventPublisher) CloseWithContext(ctx context.Context) (err error) {
+	publisher.mutex.Lock()
+	if publisher.closed {
+		publisher.mutex.Unlock()
+		return nil
+	}
+	publisher.closed = true
+	publisher.mutex.Unlock()
+
+	err = publisher.Flush(ctx)
+	if err != nil {
+		publisher.cancel(ctx.Err())
+		// The workers now go through the queue without waiting.
+		_ = publisher.Flush(context.Background())
+		err = core.SDKErrorf(ctx.Err(), "", "close-canceled", common.GetComponentInfo())
+	}
+	publisher.cancel(nil)
+	close(publisher.stop)
+	publisher.workers.Wait()
+	return
+}
+
+// done records the end of a delivery.
+func (publisher *ToolchainEventPublisher) done() {
+	publisher.mutex.Lock()
+	defer publisher.mutex.Unlock()
+	publisher.pending--
+	if publisher.pending == 0 {
+		close(publisher.idle)
+	}
+}
+
+func (publisher *ToolchainEventPublisher) work() {
+	defer publisher.workers.Done()
+	for {
+		select {
+		case <-publisher.stop:
+			return
+		case delivery := <-publisher.queue:
+			publisher.deliver(delivery)
+			publisher.report(delivery)
+		}
+	}
+}
+
+// report calls the OnDelivery callback and records the end of the delivery.
+func (publisher *ToolchainEventPublisher) report(delivery *ToolchainEventDelivery) {
+	defer publisher.done()
+	defer func() {
+		if r := recover(); r != nil {
+			core.GetLogger().Error("The OnDelivery callback of the event publisher panicked: %v", r)
+		}
+	}()
+	if publisher.options.OnDelivery != nil {
+		publisher.options.OnDelivery(delivery)
+	}
+}
+
+// deliver sends an event, retrying failures with status 429 or 5xx, until the publisher's
+// context is cancelled.
+func (publisher *ToolchainEventPublisher) deliver(delivery *ToolchainEventDelivery) {
+	retryInterval := publisher.options.RetryInterval
+	for {
+		if !publisher.wait() {
+			publisher.abandon(delivery)
+			return
+		}
+		delivery.Attempts++
+		var response *core.DetailedResponse
+		delivery.Result, response, delivery.Err = publisher.cdToolchain.PublishToolchainEventWithContext(publisher.ctx, delivery.ToolchainID, delivery.Event)
+		if delivery.Err != nil && publisher.ctx.Err() != nil {
+			publisher.abandon(delivery)
+			return
+		}
+		if delivery.Err == nil || response == nil || !common.IsRetryableStatus(response.StatusCode) || delivery.Attempts > publisher.options.MaxRetries {
+			return
+		}
+		delay, ok := common.RetryAfter(response.Headers, time.Now())
+		if !ok {
+			delay = retryInterval
+			retryInterval = min(2*retryInterval, publisher.options.MaxRetryInterval)
+		}
+		if response.StatusCode == 429 {
+			// The rate limit applies to the whole publisher.
+			publisher.delayAll(delay)
+		}
+		if !publisher.sleep(delay) {
+			publisher.abandon(delivery)
+			return
+		}
+	}
+}
+
+// abandon records that the delivery was interrupted by CloseWithContext.
+func (publisher *ToolchainEventPublisher) abandon(delivery *ToolchainEventDelivery) {
+	delivery.Result = nil
+	delivery.Err = core.SDKErrorf(context.Cause(publisher.ctx), "", "delivery-abandoned", common.GetComponentInfo())
+}
+
+// wait blocks until the rate limit allows another call. It returns false if the publisher's
+// context was cancelled first.
+func (publisher *ToolchainEventPublisher) wait() bool {
+	interval := time.Duration(float64(time.Second) / publisher.options.Rate)
+	publisher.mutex.Lock()
+	now := time.Now()
+	slot := publisher.next
+	if slot.Before(now) {
+		slot = now
+	}
+	publisher.next = slot.Add(interval)
+	publisher.mutex.Unlock()
+	return publisher.sleep(time.Until(slot))
+}
+
+// sleep pauses for delay. It returns false if the publisher's context was cancelled first.
+func (publisher *ToolchainEventPublisher) sleep(delay time.Duration) bool {
+	if publisher.ctx.Err() != nil {
+		return false
+	}
+	if delay <= 0 {
+		return true
+	}
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return true
+	case <-publisher.ctx.Done():
+		return false
+	}
+}
+
+// delayAll postpones the next call of every worker by at least delay.
+func (publisher *ToolchainEventPublisher) delayAll(delay time.Duration) {
+	publisher.mutex.Lock()
+	defer publisher.mutex.Unlock()
+	if resume := time.Now().Add(delay); publisher.next.Before(resume) {
+		publisher.next = resume
+	}
+}
@@ -0,0 +1,230 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtoolchainv2_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtoolchainv2"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`ToolchainEventPublisher`, func() {
+	var (
+		testServer         *httptest.Server
+		cdToolchainService *cdtoolchainv2.CdToolchainV2
+		handler            func(res http.ResponseWriter, req *http.Request)
+		mutex              sync.Mutex
+		deliveries         []*cdtoolchainv2.ToolchainEventDelivery
+		onDelivery         = func(delivery *cdtoolchainv2.ToolchainEventDelivery) {
+			mutex.Lock()
+			defer mutex.Unlock()
+			deliveries = append(deliveries, delivery)
+		}
+	)
+
+	BeforeEach(func() {
+		deliveries = nil
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			handler(res, req)
+		}))
+		var err error
+		cdToolchainService, err = cdtoolchainv2.NewCdToolchainV2(&cdtoolchainv2.CdToolchainV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	accept := func(res http.ResponseWriter) {
+		res.Header().Set("Content-type", "application/json")
+		res.WriteHeader(202)
+		fmt.Fprint(res, `{"id": "e1"}`)
+	}
+
+	It(`Deliver queued events`, func() {
+		var calls int32
+		handler = func(res http.ResponseWriter, req *http.Request) {
+			atomic.AddInt32(&calls, 1)
+			accept(res)
+		}
+		publisher := cdToolchainService.NewToolchainEventPublisher(&cdtoolchainv2.ToolchainEventPublisherOptions{
+			Rate: 1000, OnDelivery: onDelivery,
+		})
+		for i := 0; i < 20; i++ {
+			Expect(publisher.Publish("tc1", cdtoolchainv2.NewToolchainEvent(fmt.Sprintf("event %d", i), "d"))).To(Succeed())
+		}
+		Expect(publisher.Flush(context.Background())).To(Succeed())
+		Expect(atomic.LoadInt32(&calls)).To(Equal(int32(20)))
+		Expect(deliveries).To(HaveLen(20))
+		Expect(deliveries[0].Err).To(BeNil())
+		Expect(*deliveries[0].Result.ID).To(Equal("e1"))
+		Expect(deliveries[0].Attempts).To(Equal(1))
+
+		Expect(publisher.Publish("tc1", cdtoolchainv2.NewToolchainEvent("", "d"))).ToNot(Succeed())
+		Expect(publisher.Close()).To(Succeed())
+		err := publisher.Publish("tc1", cdtoolchainv2.NewToolchainEvent("t", "d"))
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("closed"))
+		Expect(publisher.Close()).To(Succeed())
+	})
+	It(`Retry on 429 and 5xx`, func() {
+		var calls int32
+		handler = func(res http.ResponseWriter, req *http.Request) {
+			switch atomic.AddInt32(&calls, 1) {
+			case 1:
+				res.Header().Set("Retry-After", "1")
+				res.WriteHeader(429)
+			case 2, 4:
+				res.WriteHeader(503)
+			default:
+				accept(res)
+			}
+		}
+		// The delay requested by Retry-After is not capped by MaxRetryInterval.
+		publisher := cdToolchainService.NewToolchainEventPublisher(&cdtoolchainv2.ToolchainEventPublisherOptions{
+			Workers: 1, Rate: 1000, RetryInterval: time.Millisecond, MaxRetryInterval: time.Millisecond, OnDelivery: onDelivery,
+		})
+		start := time.Now()
+		Expect(publisher.Publish("tc1", cdtoolchainv2.NewToolchainEvent("t", "d"))).To(Succeed())
+		Expect(publisher.Close()).To(Succeed())
+		Expect(time.Since(start)).To(BeNumerically(">=", time.Second))
+		Expect(deliveries).To(HaveLen(1))
+		Expect(deliveries[0].Err).To(BeNil())
+		Expect(deliveries[0].Attempts).To(Equal(3))
+
+		// A negative MaxRetries disables retries.
+		publisher = cdToolchainService.NewToolchainEventPublisher(&cdtoolchainv2.ToolchainEventPublisherOptions{
+			Workers: 1, Rate: 1000, MaxRetries: -1, OnDelivery: onDelivery,
+		})
+		Expect(publisher.Publish("tc1", cdtoolchainv2.NewToolchainEvent("t", "d"))).To(Succeed())
+		Expect(publisher.Close()).To(Succeed())
+		Expect(deliveries).To(HaveLen(2))
+		Expect(deliveries[1].Err).ToNot(BeNil())
+		Expect(deliveries[1].Attempts).To(Equal(1))
+	})
+	It(`Abandon events after the retries or on other errors`, func() {
+		handler = func(res http.ResponseWriter, req *http.Request) {
+			if req.URL.Path == "/toolchains/missing/events" {
+				res.WriteHeader(404)
+				return
+			}
+			res.WriteHeader(500)
+		}
+		publisher := cdToolchainService.NewToolchainEventPublisher(&cdtoolchainv2.ToolchainEventPublisherOptions{
+			Workers: 1, Rate: 1000, MaxRetries: 2, RetryInterval: time.Millisecond, OnDelivery: onDelivery,
+		})
+		Expect(publisher.Publish("tc1", cdtoolchainv2.NewToolchainEvent("t", "d"))).To(Succeed())
+		Expect(publisher.Publish("missing", cdtoolchainv2.NewToolchainEvent("t", "d"))).To(Succeed())
+		Expect(publisher.Close()).To(Succeed())
+		Expect(deliveries).To(HaveLen(2))
+		Expect(deliveries[0].Err).ToNot(BeNil())
+		Expect(deliveries[0].Attempts).To(Equal(3))
+		Expect(deliveries[1].Err).ToNot(BeNil())
+		Expect(deliveries[1].Attempts).To(Equal(1))
+	})
+	It(`Limit the rate and bound Flush and Publish with a context`, func() {
+		var calls int32
+		handler = func(res http.ResponseWriter, req *http.Request) {
+			atomic.AddInt32(&calls, 1)
+			accept(res)
+		}
+		publisher := cdToolchainService.NewToolchainEventPublisher(&cdtoolchainv2.ToolchainEventPublisherOptions{
+			QueueSize: 1, Workers: 1, Rate: 20,
+		})
+		start := time.Now()
+		for i := 0; i < 3; i++ {
+			Expect(publisher.Publish("tc1", cdtoolchainv2.NewToolchainEvent("t", "d"))).To(Succeed())
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+		defer cancel()
+		Expect(publisher.Flush(ctx)).ToNot(Succeed())
+		Expect(publisher.Close()).To(Succeed())
+		Expect(time.Since(start)).To(BeNumerically(">=", 100*time.Millisecond))
+		Expect(atomic.LoadInt32(&calls)).To(Equal(int32(3)))
+
+		// The worker holds the first event and the queue the second, so the third cannot be queued.
+		release := make(chan struct{})
+		handler = func(res http.ResponseWriter, req *http.Request) {
+			<-release
+			accept(res)
+		}
+		publisher = cdToolchainService.NewToolchainEventPublisher(&cdtoolchainv2.ToolchainEventPublisherOptions{QueueSize: 1, Workers: 1})
+		Expect(publisher.Publish("tc1", cdtoolchainv2.NewToolchainEvent("t", "d"))).To(Succeed())
+		Expect(publisher.Publish("tc1", cdtoolchainv2.NewToolchainEvent("t", "d"))).To(Succeed())
+		Expect(publisher.PublishWithContext(ctx, "tc1", cdtoolchainv2.NewToolchainEvent("t", "d"))).ToNot(Succeed())
+		close(release)
+		Expect(publisher.Close()).To(Succeed())
+	})
+	It(`Abandon the remaining events when CloseWithContext is cancelled`, func() {
+		handler = func(res http.ResponseWriter, req *http.Request) {
+			// The server notices that the client went away once the body is read.
+			_, _ = io.Copy(io.Discard, req.Body)
+			<-req.Context().Done()
+		}
+		publisher := cdToolchainService.NewToolchainEventPublisher(&cdtoolchainv2.ToolchainEventPublisherOptions{
+			Workers: 1, Rate: 1000, OnDelivery: onDelivery,
+		})
+		for i := 0; i < 3; i++ {
+			Expect(publisher.Publish("tc1", cdtoolchainv2.NewToolchainEvent("t", "d"))).To(Succeed())
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		start := time.Now()
+		err := publisher.CloseWithContext(ctx)
+		Expect(err).ToNot(BeNil())
+		Expect(errors.Is(err, context.DeadlineExceeded)).To(BeTrue())
+		Expect(time.Since(start)).To(BeNumerically("<", time.Second))
+		Expect(deliveries).To(HaveLen(3))
+		for _, delivery := range deliveries {
+			Expect(delivery.Result).To(BeNil())
+			Expect(errors.Is(delivery.Err, context.DeadlineExceeded)).To(BeTrue())
+		}
+		Expect(deliveries[0].Attempts).To(Equal(1))
+		Expect(deliveries[2].Attempts).To(Equal(0))
+	})
+	It(`Recover from a panic in OnDelivery`, func() {
+		handler = func(res http.ResponseWriter, req *http.Request) {
+			accept(res)
+		}
+		var calls int32
+		publisher := cdToolchainService.NewToolchainEventPublisher(&cdtoolchainv2.ToolchainEventPublisherOptions{
+			Workers: 1, Rate: 1000,
+			OnDelivery: func(delivery *cdtoolchainv2.ToolchainEventDelivery) {
+				atomic.AddInt32(&calls, 1)
+				panic("callback failed")
+			},
+		})
+		Expect(publisher.Publish("tc1", cdtoolchainv2.NewToolchainEvent("t", "d"))).To(Succeed())
+		Expect(publisher.Publish("tc1", cdtoolchainv2.NewToolchainEvent("t", "d"))).To(Succeed())
+		Expect(publisher.Close()).To(Succeed())
+		Expect(atomic.LoadInt32(&calls)).To(Equal(int32(2)))
+	})
+})
@@ -0,0 +1,166 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtoolchainv2
+
+import (
+	"context"
+	"time"
+
+	common "github.com/IBM/continuous-delivery-go-sdk/v2/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// CreateToolchainIdempotent invokes CreateToolchainIdempotentWithContext using context.Background().
+func (cdToolchain *CdToolchainV2) CreateToolchainIdempotent(createToolchainOptions *CreateToolchainOptions, idempotencyOptions *common.IdempotencyOptions) (result *ToolchainPost, response *core.DetailedResponse, err error) {
+	result, response, err = cdToolchain.CreateToolchainIdempotentWithContext(context.Background(), createToolchainOptions, idempotencyOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// CreateToolchainIdempotentWithContext creates a toolchain like CreateToolchainWithContext, except
+// that a failure leaving unknown whether the toolchain was created is retried as described by
+// common.CreateIdempotently. A toolchain of the same name and resource group that did not exist
+// before the first attempt, and was created since, is returned instead of a duplicate. Retries
+// enabled by EnableRetries are not used, as they would bypass the lookup. idempotencyOptions may
+// be nil.
+func (cdToolchain *CdToolchainV2) CreateToolchainIdempotentWithContext(ctx context.Context, createToolchainOptions *CreateToolchainOptions, idempotencyOptions *common.IdempotencyOptions) (result *ToolchainPost, response *core.DetailedResponse, err error) {
+	err = core.ValidateNotNil(createToolchainOptions, "createToolchainOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(createToolchainOptions, "createToolchainOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+	service := cdToolchain.withoutRetries()
+	since := time.Now().Add(-common.IdempotencyClockSkew)
+
+	// findToolchains calls yield with each toolchain of the same name and resource group.
+	findToolchains := func(ctx context.Context, yield func(toolchain *ToolchainModel)) error {
+		listOptions := service.NewListToolchainsOptions(*createToolchainOptions.ResourceGroupID)
+		listOptions.SetName(*createToolchainOptions.Name)
+		for toolchain, err := range service.Toolchains(ctx, listOptions) {
+			if err != nil {
+				return err
+			}
+			if core.StringNilMapper(toolchain.Name) == *createToolchainOptions.Name {
+				yield(&toolchain)
+			}
+		}
+		return nil
+	}
+	existing := map[string]bool{}
+	err = findToolchains(ctx, func(toolchain *ToolchainModel) {
+		existing[core.StringNilMapper(toolchain.ID)] = true
+	})
+	if err != nil {
+		err = core.SDKErrorf(err, "", "list-toolchains-error", common.GetComponentInfo())
+		return
+	}
+
+	return common.CreateIdempotently(ctx, idempotencyOptions,
+		func(ctx context.Context) (*ToolchainPost, *core.DetailedResponse, error) {
+			return service.CreateToolchainWithContext(ctx, createToolchainOptions)
+		},
+		func(ctx context.Context) (created *ToolchainPost, found bool, err error) {
+			err = findToolchains(ctx, func(toolchain *ToolchainModel) {
+				if !found && !existing[core.StringNilMapper(toolchain.ID)] &&
+					toolchain.CreatedAt != nil && !time.Time(*toolchain.CreatedAt).Before(since) {
+					post := ToolchainPost(*toolchain)
+					created, found = &post, true
+				}
+			})
+			return
+		})
+}
+
+// CreateToolIdempotent invokes CreateToolIdempotentWithContext using context.Background().
+func (cdToolchain *CdToolchainV2) CreateToolIdempotent(createToolOptions *CreateToolOptions, idempotencyOptions *common.IdempotencyOptions) (result *ToolchainToolPost, response *core.DetailedResponse, err error) {
+	result, response, err = cdToolchain.CreateToolIdempotentWithContext(context.Background(), createToolOptions, idempotencyOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// CreateToolIdempotentWithContext creates a tool like CreateToolWithContext, except that a failure
+// leaving unknown whether the tool was created is retried as described by
+// common.CreateIdempotently. A tool of the same name and type that did not exist before the first
+// attempt is returned instead of a duplicate, so the name of the tool must be set. Retries
+// enabled by EnableRetries are not used, as they would bypass the lookup. idempotencyOptions may
+// be nil.
+func (cdToolchain *CdToolchainV2) CreateToolIdempotentWithContext(ctx context.Context, createToolOptions *CreateToolOptions, idempotencyOptions *common.IdempotencyOptions) (result *ToolchainToolPost, response *core.DetailedResponse, err error) {
+	err = core.ValidateNotNil(createToolOptions, "createToolOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(createToolOptions, "createToolOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+	if core.StringNilMapper(createToolOptions.Name) == "" {
+		err = core.SDKErrorf(nil, "the 'createToolOptions.Name' field must be set to identify the tool", "missing-tool-name", common.GetComponentInfo())
+		return
+	}
+	service := cdToolchain.withoutRetries()
+
+	// Tools do not record when they were created, so the tools that exist before the first attempt
+	// are what tells a tool it created from one created or edited by someone else.
+	findTools := func(ctx context.Context, yield func(tool *ToolModel)) error {
+		for tool, err := range service.Tools(ctx, service.NewListToolsOptions(*createToolOptions.ToolchainID)) {
+			if err != nil {
+				return err
+			}
+			if core.StringNilMapper(tool.Name) == *createToolOptions.Name &&
+				core.StringNilMapper(tool.ToolTypeID) == *createToolOptions.ToolTypeID {
+				yield(&tool)
+			}
+		}
+		return nil
+	}
+	existing := map[string]bool{}
+	err = findTools(ctx, func(tool *ToolModel) {
+		existing[core.StringNilMapper(tool.ID)] = true
+	})
+	if err != nil {
+		err = core.SDKErrorf(err, "", "list-tools-error", common.GetComponentInfo())
+		return
+	}
+
+	return common.CreateIdempotently(ctx, idempotencyOptions,
+		func(ctx context.Context) (*ToolchainToolPost, *core.DetailedResponse, error) {
+			return service.CreateToolWithContext(ctx, createToolOptions)
+		},
+		func(ctx context.Context) (created *ToolchainToolPost, found bool, err error) {
+			err = findTools(ctx, func(tool *ToolModel) {
+				if !found && !existing[core.StringNilMapper(tool.ID)] {
+					post := ToolchainToolPost(*tool)
+					created, found = &post, true
+				}
+			})
+			return
+		})
+}
+
+// withoutRetries returns a clone of this service instance with automatic retries disabled.
+func (cdToolchain *CdToolchainV2) withoutRetries() *CdToolchainV2 {
+	clone := cdToolchain.Clone()
+	clone.DisableRetries()
+	return clone
+}
@@ -0,0 +1,106 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtoolchainv2_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtoolchainv2"
+	common "github.com/IBM/continuous-delivery-go-sdk/v2/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Idempotent create operations`, func() {
+	var (
+		testServer         *httptest.Server
+		cdToolchainService *cdtoolchainv2.CdToolchainV2
+		requests           []string
+		created            bool
+		createdAt          string
+	)
+	idempotencyOptions := &common.IdempotencyOptions{RetryInterval: time.Millisecond}
+
+	BeforeEach(func() {
+		requests, created = nil, false
+		createdAt = time.Now().UTC().Format(time.RFC3339)
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+			requests = append(requests, req.Method+" "+req.URL.Path)
+			res.Header().Set("Content-type", "application/json")
+			switch req.Method + " " + req.URL.Path {
+			case "POST /toolchains", "POST /toolchains/tc1/tools":
+				// The first attempt is carried out, but its response is lost.
+				created = true
+				res.WriteHeader(503)
+				fmt.Fprint(res, `{"errors": [{"code": "unavailable", "message": "Service unavailable"}]}`)
+			case "GET /toolchains":
+				Expect(req.URL.Query().Get("name")).To(Equal("tc"))
+				// Another client created a toolchain of the same name just before.
+				toolchains := []map[string]string{
+					{"id": "old", "name": "tc", "created_at": "2020-01-01T00:00:00Z"},
+					{"id": "other", "name": "tc", "created_at": createdAt},
+				}
+				if created {
+					toolchains = append(toolchains, map[string]string{"id": "tc1", "name": "tc", "created_at": createdAt})
+				}
+				Expect(json.NewEncoder(res).Encode(map[string]interface{}{"toolchains": toolchains})).To(Succeed())
+			case "GET /toolchains/tc1/tools":
+				// A tool of the same name and type was edited just before the tool was created.
+				tools := []map[string]string{{"id": "t0", "name": "chat", "tool_type_id": "slack", "updated_at": createdAt}}
+				if created {
+					tools = append(tools, map[string]string{"id": "t1", "name": "chat", "tool_type_id": "slack", "updated_at": createdAt})
+				}
+				Expect(json.NewEncoder(res).Encode(map[string]interface{}{"tools": tools})).To(Succeed())
+			}
+		}))
+		var err error
+		cdToolchainService, err = cdtoolchainv2.NewCdToolchainV2(&cdtoolchainv2.CdToolchainV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+		cdToolchainService.EnableRetries(3, time.Millisecond)
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Return the toolchain created by an ambiguous attempt`, func() {
+		result, response, err := cdToolchainService.CreateToolchainIdempotent(cdToolchainService.NewCreateToolchainOptions("tc", "rg1"), idempotencyOptions)
+		Expect(err).To(BeNil())
+		Expect(*result.ID).To(Equal("tc1"))
+		Expect(response.StatusCode).To(Equal(200))
+		Expect(requests).To(Equal([]string{"GET /toolchains", "POST /toolchains", "GET /toolchains"}))
+	})
+	It(`Return the tool created by an ambiguous attempt`, func() {
+		createToolOptions := cdToolchainService.NewCreateToolOptions("tc1", "slack")
+		_, _, err := cdToolchainService.CreateToolIdempotent(createToolOptions, idempotencyOptions)
+		Expect(err).ToNot(BeNil())
+		Expect(requests).To(BeEmpty())
+
+		result, _, err := cdToolchainService.CreateToolIdempotent(createToolOptions.SetName("chat"), idempotencyOptions)
+		Expect(err).To(BeNil())
+		Expect(*result.ID).To(Equal("t1"))
+		Expect(requests).To(Equal([]string{"GET /toolchains/tc1/tools", "POST /toolchains/tc1/tools", "GET /toolchains/tc1/tools"}))
+	})
+})
@@ -0,0 +1,78 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtoolchainv2
+
+import (
+	"context"
+	"iter"
+
+	common "github.com/IBM/continuous-delivery-go-sdk/v2/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Items returns an iterator over the remaining toolchains of the pager. Pages are fetched while
+// the loop runs, and no further page is fetched once it breaks. An error ends the iteration.
+func (pager *ToolchainsPager) Items(ctx context.Context) iter.Seq2[ToolchainModel, error] {
+	return common.PageItems(ctx, pager.HasNext, pager.GetNextWithContext)
+}
+
+// PrefetchItems is like Items, except that pages are fetched by a background goroutine that runs
+// ahead of the loop within the bounds of options, which may be nil. The pager must not be used
+// otherwise while the loop runs.
+func (pager *ToolchainsPager) PrefetchItems(ctx context.Context, options *common.PrefetchOptions) iter.Seq2[ToolchainModel, error] {
+	return common.PrefetchItems(ctx, pager.HasNext, pager.GetNextWithContext, options)
+}
+
+// Toolchains returns an iterator over the toolchains matching options, as described by
+// ToolchainsPager.Items.
+func (cdToolchain *CdToolchainV2) Toolchains(ctx context.Context, options *ListToolchainsOptions) iter.Seq2[ToolchainModel, error] {
+	err := core.ValidateNotNil(options, "options cannot be nil")
+	if err != nil {
+		return common.ErrorItems[ToolchainModel](core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo()))
+	}
+	pager, err := cdToolchain.NewToolchainsPager(options)
+	if err != nil {
+		return common.ErrorItems[ToolchainModel](err)
+	}
+	return pager.Items(ctx)
+}
+
+// Items returns an iterator over the remaining tools of the pager. Pages are fetched while the
+// loop runs, and no further page is fetched once it breaks. An error ends the iteration.
+func (pager *ToolsPager) Items(ctx context.Context) iter.Seq2[ToolModel, error] {
+	return common.PageItems(ctx, pager.HasNext, pager.GetNextWithContext)
+}
+
+// PrefetchItems is like Items, except that pages are fetched by a background goroutine that runs
+// ahead of the loop within the bounds of options, which may be nil. The pager must not be used
+// otherwise while the loop runs.
+func (pager *ToolsPager) PrefetchItems(ctx context.Context, options *common.PrefetchOptions) iter.Seq2[ToolModel, error] {
+	return common.PrefetchItems(ctx, pager.HasNext, pager.GetNextWithContext, options)
+}
+
+// Tools returns an iterator over the tools matching options, as described by ToolsPager.Items.
+func (cdToolchain *CdToolchainV2) Tools(ctx context.Context, options *ListToolsOptions) iter.Seq2[ToolModel, error] {
+	err := core.ValidateNotNil(options, "options cannot be nil")
+	if err != nil {
+		return common.ErrorItems[ToolModel](core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo()))
+	}
+	pager, err := cdToolchain.NewToolsPager(options)
+	if err != nil {
+		return common.ErrorItems[ToolModel](err)
+	}
+	return pager.Items(ctx)
+}
@@ -0,0 +1,129 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtoolchainv2_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtoolchainv2"
+	common "github.com/IBM/continuous-delivery-go-sdk/v2/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Iterators`, func() {
+	var (
+		testServer         *httptest.Server
+		cdToolchainService *cdtoolchainv2.CdToolchainV2
+		requests           []string
+	)
+
+	BeforeEach(func() {
+		requests = nil
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+			start := req.URL.Query().Get("start")
+			requests = append(requests, req.URL.Path+"?start="+start)
+			res.Header().Set("Content-type", "application/json")
+			switch req.URL.Path + "?start=" + start {
+			case "/toolchains?start=":
+				fmt.Fprint(res, `{"toolchains": [{"id": "tc1"}, {"id": "tc2"}], "next": {"start": "2"}}`)
+			case "/toolchains?start=2":
+				fmt.Fprint(res, `{"toolchains": [{"id": "tc3"}]}`)
+			case "/toolchains/tc1/tools?start=":
+				fmt.Fprint(res, `{"tools": [{"id": "t1"}], "next": {"start": "1"}}`)
+			default:
+				res.WriteHeader(500)
+				fmt.Fprint(res, `{"errors": [{"message": "boom"}]}`)
+			}
+		}))
+		var err error
+		cdToolchainService, err = cdtoolchainv2.NewCdToolchainV2(&cdtoolchainv2.CdToolchainV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Iterate over all toolchains`, func() {
+		var ids []string
+		for toolchain, err := range cdToolchainService.Toolchains(context.Background(), cdToolchainService.NewListToolchainsOptions("rg1")) {
+			Expect(err).To(BeNil())
+			ids = append(ids, *toolchain.ID)
+		}
+		Expect(ids).To(Equal([]string{"tc1", "tc2", "tc3"}))
+		Expect(requests).To(HaveLen(2))
+	})
+	It(`Stop fetching when the loop breaks`, func() {
+		for toolchain := range cdToolchainService.Toolchains(context.Background(), cdToolchainService.NewListToolchainsOptions("rg1")) {
+			if *toolchain.ID == "tc2" {
+				break
+			}
+		}
+		Expect(requests).To(Equal([]string{"/toolchains?start="}))
+	})
+	It(`Yield errors`, func() {
+		var ids []string
+		var errs []error
+		for tool, err := range cdToolchainService.Tools(context.Background(), cdToolchainService.NewListToolsOptions("tc1")) {
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			ids = append(ids, *tool.ID)
+		}
+		Expect(ids).To(Equal([]string{"t1"}))
+		Expect(errs).To(HaveLen(1))
+		Expect(errs[0].Error()).To(ContainSubstring("boom"))
+
+		errs = nil
+		for _, err := range cdToolchainService.Tools(context.Background(), nil) {
+			errs = append(errs, err)
+		}
+		Expect(errs).To(HaveLen(1))
+		options := cdToolchainService.NewListToolchainsOptions("rg1").SetStart("2")
+		for _, err := range cdToolchainService.Toolchains(context.Background(), options) {
+			Expect(err).ToNot(BeNil())
+		}
+	})
+	It(`Prefetch toolchains`, func() {
+		pager, err := cdToolchainService.NewToolchainsPager(cdToolchainService.NewListToolchainsOptions("rg1"))
+		Expect(err).To(BeNil())
+		var ids []string
+		for toolchain, err := range pager.PrefetchItems(context.Background(), &common.PrefetchOptions{MaxBufferedItems: 10}) {
+			Expect(err).To(BeNil())
+			ids = append(ids, *toolchain.ID)
+		}
+		Expect(ids).To(Equal([]string{"tc1", "tc2", "tc3"}))
+
+		toolsPager, err := cdToolchainService.NewToolsPager(cdToolchainService.NewListToolsOptions("tc1"))
+		Expect(err).To(BeNil())
+		var errs []error
+		for _, err := range toolsPager.PrefetchItems(context.Background(), nil) {
+			errs = append(errs, err)
+		}
+		Expect(errs).To(HaveLen(2))
+		Expect(errs[1]).ToNot(BeNil())
+	})
+})
@@ -0,0 +1,85 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtoolchainv2_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync/atomic"
+
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtoolchainv2"
+	common "github.com/IBM/continuous-delivery-go-sdk/v2/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Client rate limiting`, func() {
+	It(`Adapt the rate of a client and its clones to 429 responses`, func() {
+		var calls int32
+		testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			res.Header().Set("Content-type", "application/json")
+			if atomic.AddInt32(&calls, 1) == 1 {
+				res.Header().Set("Retry-After", "0")
+				res.WriteHeader(429)
+				fmt.Fprint(res, `{"errors": [{"code": "too_many_requests", "message": "Rate limit exceeded"}]}`)
+				return
+			}
+			res.WriteHeader(200)
+			fmt.Fprint(res, `{"id": "tc1"}`)
+		}))
+		defer testServer.Close()
+
+		limiter := common.NewRateLimiter(&common.RateLimiterOptions{Rate: 100, Burst: 5, MaxInFlight: 2})
+		cdToolchainService, err := cdtoolchainv2.NewCdToolchainV2(&cdtoolchainv2.CdToolchainV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+			RateLimiter:   limiter,
+		})
+		Expect(err).To(BeNil())
+		cdToolchainService.EnableRetries(2, 0)
+		clone := cdToolchainService.Clone()
+
+		result, _, err := clone.GetToolchainByID(clone.NewGetToolchainByIDOptions("tc1"))
+		Expect(err).To(BeNil())
+		Expect(*result.ID).To(Equal("tc1"))
+		Expect(atomic.LoadInt32(&calls)).To(Equal(int32(2)))
+		Expect(limiter.Rate()).To(Equal(55.0))
+
+		_, _, err = cdToolchainService.GetToolchainByID(cdToolchainService.NewGetToolchainByIDOptions("tc1"))
+		Expect(err).To(BeNil())
+		Expect(limiter.Rate()).To(Equal(60.0))
+		Expect(limiter.InFlight()).To(Equal(0))
+
+		cdToolchainService.DisableRetries()
+		_, ok := cdToolchainService.Service.GetHTTPClient().Transport.(*common.RateLimitTransport)
+		Expect(ok).To(BeTrue())
+	})
+	It(`Honor DISABLE_SSL in the external configuration of a rate-limited client`, func() {
+		os.Setenv("CD_TOOLCHAIN_DISABLE_SSL", "true")
+		defer os.Unsetenv("CD_TOOLCHAIN_DISABLE_SSL")
+		cdToolchainService, err := cdtoolchainv2.NewCdToolchainV2UsingExternalConfig(&cdtoolchainv2.CdToolchainV2Options{
+			URL:           "https://cd.example.com",
+			Authenticator: &core.NoAuthAuthenticator{},
+			RateLimiter:   common.NewRateLimiter(nil),
+		})
+		Expect(err).To(BeNil())
+		Expect(cdToolchainService.IsSSLDisabled()).To(BeTrue())
+	})
+})
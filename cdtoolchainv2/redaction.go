@@ -0,0 +1,34 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtoolchainv2
+
+import (
+	common "github.com/IBM/continuous-delivery-go-sdk/v2/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// EnableDebugLogging logs every request and response of this service instance at debug level,
+// with credentials such as tool API keys and tokens redacted from headers and bodies.
+// If logger is nil, the core library's logger is used.
+func (cdToolchain *CdToolchainV2) EnableDebugLogging(logger core.Logger) {
+	common.EnableDebugLogging(cdToolchain.Service, logger)
+}
+
+// DisableDebugLogging stops the logging enabled by EnableDebugLogging.
+func (cdToolchain *CdToolchainV2) DisableDebugLogging() {
+	common.DisableDebugLogging(cdToolchain.Service)
+}
@@ -0,0 +1,86 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtoolchainv2_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtoolchainv2"
+	common "github.com/IBM/continuous-delivery-go-sdk/v2/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Response cache`, func() {
+	It(`Cache toolchain and tool lookups until they are modified`, func() {
+		var requests []string
+		testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			requests = append(requests, req.Method+" "+req.URL.Path)
+			res.Header().Set("Content-type", "application/json")
+			switch req.Method {
+			case "GET":
+				fmt.Fprintf(res, `{"id": "x", "name": "%d"}`, len(requests))
+			case "PATCH":
+				fmt.Fprint(res, `{"id": "t1"}`)
+			default:
+				res.WriteHeader(204)
+			}
+		}))
+		defer testServer.Close()
+
+		cdToolchainService, err := cdtoolchainv2.NewCdToolchainV2(&cdtoolchainv2.CdToolchainV2Options{
+			URL:           testServer.URL + "/toolchain/v2",
+			Authenticator: &core.NoAuthAuthenticator{},
+			ResponseCache: common.NewResponseCache(nil),
+		})
+		Expect(err).To(BeNil())
+		clone := cdToolchainService.Clone()
+		getToolchain := func() string {
+			toolchain, _, err := cdToolchainService.GetToolchainByID(cdToolchainService.NewGetToolchainByIDOptions("tc1"))
+			Expect(err).To(BeNil())
+			return *toolchain.Name
+		}
+		getTool := func() string {
+			tool, _, err := clone.GetToolByID(clone.NewGetToolByIDOptions("tc1", "t1"))
+			Expect(err).To(BeNil())
+			return *tool.Name
+		}
+
+		Expect(getToolchain()).To(Equal("1"))
+		Expect(getTool()).To(Equal("2"))
+		Expect(getToolchain()).To(Equal("1"))
+		Expect(getTool()).To(Equal("2"))
+		_, _, err = cdToolchainService.ListToolchains(cdToolchainService.NewListToolchainsOptions("rg1"))
+		Expect(err).To(BeNil())
+		_, _, err = cdToolchainService.ListToolchains(cdToolchainService.NewListToolchainsOptions("rg1"))
+		Expect(err).To(BeNil())
+		Expect(requests).To(HaveLen(4))
+
+		_, _, err = clone.UpdateTool(clone.NewUpdateToolOptions("tc1", "t1", map[string]interface{}{"name": "n"}))
+		Expect(err).To(BeNil())
+		Expect(getTool()).To(Equal("6"))
+		Expect(getToolchain()).To(Equal("7"))
+
+		_, err = cdToolchainService.DeleteToolchain(cdToolchainService.NewDeleteToolchainOptions("tc1"))
+		Expect(err).To(BeNil())
+		Expect(getTool()).To(Equal("9"))
+		Expect(requests[8]).To(Equal("GET /toolchain/v2/toolchains/tc1/tools/t1"))
+	})
+})
@@ -0,0 +1,75 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtoolchainv2_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtoolchainv2"
+	common "github.com/IBM/continuous-delivery-go-sdk/v2/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+type recordingCounter struct {
+	attributes [][]common.Attribute
+}
+
+func (counter *recordingCounter) Add(ctx context.Context, incr int64, attributes ...common.Attribute) {
+	counter.attributes = append(counter.attributes, attributes)
+}
+
+type recordingHistogram struct {
+	values []float64
+}
+
+func (histogram *recordingHistogram) Record(ctx context.Context, value float64, attributes ...common.Attribute) {
+	histogram.values = append(histogram.values, value)
+}
+
+var _ = Describe(`Client telemetry`, func() {
+	It(`Measure each operation`, func() {
+		testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			res.Header().Set("Content-type", "application/json")
+			res.WriteHeader(404)
+			fmt.Fprint(res, `{"errors": [{"code": "not_found", "message": "Toolchain not found"}]}`)
+		}))
+		defer testServer.Close()
+
+		errorCounts, durations := &recordingCounter{}, &recordingHistogram{}
+		cdToolchainService, err := cdtoolchainv2.NewCdToolchainV2(&cdtoolchainv2.CdToolchainV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+			Telemetry:     common.NewTelemetry(&common.TelemetryOptions{RequestDuration: durations, RequestErrors: errorCounts}),
+		})
+		Expect(err).To(BeNil())
+
+		_, _, err = cdToolchainService.GetToolchainByID(cdToolchainService.NewGetToolchainByIDOptions("tc1"))
+		Expect(err).ToNot(BeNil())
+		Expect(durations.values).To(HaveLen(1))
+		Expect(errorCounts.attributes).To(Equal([][]common.Attribute{{
+			{Key: common.AttributeOperation, Value: "GetToolchainByID"},
+			{Key: common.AttributeHTTPMethod, Value: "GET"},
+			{Key: common.AttributeHTTPStatusCode, Value: 404},
+			{Key: common.AttributeErrorType, Value: "404"},
+		}}))
+	})
+})
@@ -0,0 +1,457 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtoolchainv2
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	common "github.com/IBM/continuous-delivery-go-sdk/v2/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Constants associated with the ToolTypeID of the tool integrations that have typed parameters.
+const (
+	ToolTypeIDGithubConsolidatedConst = "githubconsolidated"
+	ToolTypeIDGitlabConst             = "gitlab"
+	ToolTypeIDHostedGitConst          = "hostedgit"
+	ToolTypeIDPipelineConst           = "pipeline"
+	ToolTypeIDSlackConst              = "slack"
+	ToolTypeIDSecretsManagerConst     = "secretsmanager"
+	ToolTypeIDKeyProtectConst         = "keyprotect"
+	ToolTypeIDPrivateWorkerConst      = "private_worker"
+)
+
+// ToolParameters : The typed parameters of a tool integration.
+type ToolParameters interface {
+	// ToolTypeID returns the tool type the parameters apply to.
+	ToolTypeID() string
+
+	// Validate checks that the required parameters are set and that enumerated parameters have
+	// valid values.
+	Validate() error
+}
+
+// toolParameterTypes creates an empty parameter model for each tool type with typed parameters.
+var toolParameterTypes = map[string]func() ToolParameters{
+	ToolTypeIDGithubConsolidatedConst: func() ToolParameters { return &GithubConsolidatedToolParameters{} },
+	ToolTypeIDGitlabConst:             func() ToolParameters { return &GitlabToolParameters{} },
+	ToolTypeIDHostedGitConst:          func() ToolParameters { return &HostedGitToolParameters{} },
+	ToolTypeIDPipelineConst:           func() ToolParameters { return &PipelineToolParameters{} },
+	ToolTypeIDSlackConst:              func() ToolParameters { return &SlackToolParameters{} },
+	ToolTypeIDSecretsManagerConst:     func() ToolParameters { return &SecretsManagerToolParameters{} },
+	ToolTypeIDKeyProtectConst:         func() ToolParameters { return &KeyProtectToolParameters{} },
+	ToolTypeIDPrivateWorkerConst:      func() ToolParameters { return &PrivateWorkerToolParameters{} },
+}
+
+// TypedToolTypeIDs returns the tool types that have typed parameters, sorted.
+func TypedToolTypeIDs() []string {
+	ids := make([]string, 0, len(toolParameterTypes))
+	for id := range toolParameterTypes {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// Constants associated with the GitRepoToolParameters.Type property.
+const (
+	GitRepoToolParametersTypeNewConst   = "new"
+	GitRepoToolParametersTypeForkConst  = "fork"
+	GitRepoToolParametersTypeCloneConst = "clone"
+	GitRepoToolParametersTypeLinkConst  = "link"
+)
+
+// Constants associated with the GitRepoToolParameters.AuthType property.
+const (
+	GitRepoToolParametersAuthTypeOauthConst = "oauth"
+	GitRepoToolParametersAuthTypePatConst   = "pat"
+)
+
+// GitRepoToolParameters : The parameters shared by the Git repository tool integrations.
+type GitRepoToolParameters struct {
+	// The Git server, for example "github" or "integrated".
+	GitID *string `json:"git_id,omitempty"`
+
+	// The API root URL of a private Git server.
+	APIRootURL *string `json:"api_root_url,omitempty" validate:"omitempty,url"`
+
+	// How the repository is set up: new, fork, clone or link.
+	Type *string `json:"type" validate:"required,oneof=new fork clone link"`
+
+	// The URL of the repository to link to, or of the repository that is created.
+	RepoURL *string `json:"repo_url,omitempty" validate:"required_if=Type link,omitempty,url"`
+
+	// The URL of the repository to fork or clone.
+	SourceRepoURL *string `json:"source_repo_url,omitempty" validate:"required_if=Type fork,required_if=Type clone,omitempty,url"`
+
+	// The name of the repository to create.
+	RepoName *string `json:"repo_name,omitempty" validate:"required_if=Type new"`
+
+	// The user or organization that owns the created repository.
+	OwnerID *string `json:"owner_id,omitempty"`
+
+	// Whether a created repository is private.
+	PrivateRepo *bool `json:"private_repo,omitempty"`
+
+	// Whether commits are tracked in Git issues.
+	EnableTraceability *bool `json:"enable_traceability,omitempty"`
+
+	// Whether issues of the repository are shown in the toolchain.
+	ToolchainIssuesEnabled *bool `json:"toolchain_issues_enabled,omitempty"`
+
+	// The user whose credentials the integration uses.
+	IntegrationOwner *string `json:"integration_owner,omitempty"`
+
+	// The authentication type: oauth or pat.
+	AuthType *string `json:"auth_type,omitempty" validate:"omitempty,oneof=oauth pat"`
+
+	// The personal access token, when AuthType is pat.
+	APIToken *string `json:"api_token,omitempty" validate:"required_if=AuthType pat"`
+
+	// The default branch of a created repository.
+	DefaultBranch *string `json:"default_branch,omitempty"`
+
+	// Whether the server is only reachable through a private worker.
+	BlindConnection *bool `json:"blind_connection,omitempty"`
+}
+
+// GithubConsolidatedToolParameters : The parameters of the GitHub tool integration.
+type GithubConsolidatedToolParameters struct {
+	GitRepoToolParameters
+}
+
+// ToolTypeID implements ToolParameters.
+func (*GithubConsolidatedToolParameters) ToolTypeID() string {
+	return ToolTypeIDGithubConsolidatedConst
+}
+
+// Validate implements ToolParameters.
+func (parameters *GithubConsolidatedToolParameters) Validate() error {
+	return core.ValidateStruct(parameters, "parameters")
+}
+
+// GitlabToolParameters : The parameters of the GitLab tool integration.
+type GitlabToolParameters struct {
+	GitRepoToolParameters
+}
+
+// ToolTypeID implements ToolParameters.
+func (*GitlabToolParameters) ToolTypeID() string {
+	return ToolTypeIDGitlabConst
+}
+
+// Validate implements ToolParameters.
+func (parameters *GitlabToolParameters) Validate() error {
+	return core.ValidateStruct(parameters, "parameters")
+}
+
+// HostedGitToolParameters : The parameters of the Git Repos and Issue Tracking tool integration.
+type HostedGitToolParameters struct {
+	GitRepoToolParameters
+}
+
+// ToolTypeID implements ToolParameters.
+func (*HostedGitToolParameters) ToolTypeID() string {
+	return ToolTypeIDHostedGitConst
+}
+
+// Validate implements ToolParameters.
+func (parameters *HostedGitToolParameters) Validate() error {
+	return core.ValidateStruct(parameters, "parameters")
+}
+
+// Constants associated with the PipelineToolParameters.Type property.
+const (
+	PipelineToolParametersTypeClassicConst = "classic"
+	PipelineToolParametersTypeTektonConst  = "tekton"
+)
+
+// PipelineToolParameters : The parameters of the delivery pipeline tool integration.
+type PipelineToolParameters struct {
+	// The name of the pipeline.
+	Name *string `json:"name" validate:"required"`
+
+	// The pipeline type: classic or tekton.
+	Type *string `json:"type,omitempty" validate:"omitempty,oneof=classic tekton"`
+
+	// Whether the pipeline is shown in the toolchain's user interface only.
+	UIPipeline *bool `json:"ui_pipeline,omitempty"`
+}
+
+// ToolTypeID implements ToolParameters.
+func (*PipelineToolParameters) ToolTypeID() string {
+	return ToolTypeIDPipelineConst
+}
+
+// Validate implements ToolParameters.
+func (parameters *PipelineToolParameters) Validate() error {
+	return core.ValidateStruct(parameters, "parameters")
+}
+
+// SlackToolParameters : The parameters of the Slack tool integration.
+type SlackToolParameters struct {
+	// The Slack channel that notifications are posted to.
+	ChannelName *string `json:"channel_name" validate:"required"`
+
+	// The incoming webhook URL.
+	Webhook *string `json:"webhook" validate:"required"`
+
+	// The Slack team name.
+	TeamName *string `json:"team_name,omitempty"`
+
+	// Whether to post when a pipeline run starts.
+	PipelineStart *bool `json:"pipeline_start,omitempty"`
+
+	// Whether to post when a pipeline run succeeds.
+	PipelineSuccess *bool `json:"pipeline_success,omitempty"`
+
+	// Whether to post when a pipeline run fails.
+	PipelineFail *bool `json:"pipeline_fail,omitempty"`
+
+	// Whether to post when a tool is bound to the toolchain.
+	ToolchainBind *bool `json:"toolchain_bind,omitempty"`
+
+	// Whether to post when a tool is unbound from the toolchain.
+	ToolchainUnbind *bool `json:"toolchain_unbind,omitempty"`
+}
+
+// ToolTypeID implements ToolParameters.
+func (*SlackToolParameters) ToolTypeID() string {
+	return ToolTypeIDSlackConst
+}
+
+// Validate implements ToolParameters.
+func (parameters *SlackToolParameters) Validate() error {
+	return core.ValidateStruct(parameters, "parameters")
+}
+
+// Constants associated with the SecretsManagerToolParameters.InstanceIDType property.
+const (
+	SecretsManagerToolParametersInstanceIDTypeInstanceNameConst = "instance-name"
+	SecretsManagerToolParametersInstanceIDTypeInstanceCRNConst  = "instance-crn"
+)
+
+// SecretsManagerToolParameters : The parameters of the Secrets Manager tool integration.
+type SecretsManagerToolParameters struct {
+	// The name of the integration.
+	Name *string `json:"name" validate:"required"`
+
+	// How the instance is identified: instance-name or instance-crn.
+	InstanceIDType *string `json:"instance_id_type,omitempty" validate:"omitempty,oneof=instance-name instance-crn"`
+
+	// The instance name, unless InstanceIDType is instance-crn.
+	InstanceName *string `json:"instance_name,omitempty" validate:"required_unless=InstanceIDType instance-crn"`
+
+	// The instance CRN, when InstanceIDType is instance-crn.
+	InstanceCRN *string `json:"instance_crn,omitempty" validate:"required_if=InstanceIDType instance-crn"`
+
+	// The region of the instance.
+	Location *string `json:"location,omitempty"`
+
+	// The resource group of the instance.
+	ResourceGroupName *string `json:"resource_group_name,omitempty"`
+}
+
+// ToolTypeID implements ToolParameters.
+func (*SecretsManagerToolParameters) ToolTypeID() string {
+	return ToolTypeIDSecretsManagerConst
+}
+
+// Validate implements ToolParameters.
+func (parameters *SecretsManagerToolParameters) Validate() error {
+	return core.ValidateStruct(parameters, "parameters")
+}
+
+// KeyProtectToolParameters : The parameters of the Key Protect tool integration.
+type KeyProtectToolParameters struct {
+	// The name of the integration.
+	Name *string `json:"name" validate:"required"`
+
+	// The instance name.
+	InstanceName *string `json:"instance_name" validate:"required"`
+
+	// The region of the instance.
+	Location *string `json:"location" validate:"required"`
+
+	// The resource group of the instance.
+	ResourceGroupName *string `json:"resource_group_name" validate:"required"`
+}
+
+// ToolTypeID implements ToolParameters.
+func (*KeyProtectToolParameters) ToolTypeID() string {
+	return ToolTypeIDKeyProtectConst
+}
+
+// Validate implements ToolParameters.
+func (parameters *KeyProtectToolParameters) Validate() error {
+	return core.ValidateStruct(parameters, "parameters")
+}
+
+// PrivateWorkerToolParameters : The parameters of the private worker tool integration.
+type PrivateWorkerToolParameters struct {
+	// The name of the integration.
+	Name *string `json:"name" validate:"required"`
+
+	// The service ID API key the worker uses to read its queue.
+	WorkerQueueCredentials *string `json:"worker_queue_credentials" validate:"required"`
+
+	// The queue identifier, set by the service.
+	WorkerQueueIdentifier *string `json:"worker_queue_identifier,omitempty"`
+}
+
+// ToolTypeID implements ToolParameters.
+func (*PrivateWorkerToolParameters) ToolTypeID() string {
+	return ToolTypeIDPrivateWorkerConst
+}
+
+// Validate implements ToolParameters.
+func (parameters *PrivateWorkerToolParameters) Validate() error {
+	return core.ValidateStruct(parameters, "parameters")
+}
+
+// ToolParametersToMap validates parameters and converts them to the map used by CreateToolOptions
+// and the tool patch models.
+func ToolParametersToMap(parameters ToolParameters) (result map[string]interface{}, err error) {
+	err = core.ValidateNotNil(parameters, "parameters cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	if err = parameters.Validate(); err != nil {
+		err = core.SDKErrorf(err, "", "tool-parameters-invalid", common.GetComponentInfo())
+		return
+	}
+	b, err := json.Marshal(parameters)
+	if err == nil {
+		err = json.Unmarshal(b, &result)
+	}
+	if err != nil {
+		err = core.SDKErrorf(err, "", "tool-parameters-conversion-error", common.GetComponentInfo())
+	}
+	return
+}
+
+// ToolParametersFromMap converts a parameter map to the typed parameters of toolTypeID. Keys that
+// the typed model does not define are ignored. The result is not validated.
+func ToolParametersFromMap(toolTypeID string, parameters map[string]interface{}) (result ToolParameters, err error) {
+	result, err = decodeToolParameters(toolTypeID, parameters)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "tool-parameters-conversion-error", common.GetComponentInfo())
+	}
+	return
+}
+
+// ValidateToolParameters checks a parameter map against the typed parameters of toolTypeID,
+// reporting missing or invalid values. The service accepts parameters that the typed models do
+// not define, so unknown keys are not an error: they are returned in unknownKeys, sorted, for the
+// caller to check for misspelled keys. Maps for tool types without typed parameters are not
+// checked.
+func ValidateToolParameters(toolTypeID string, parameters map[string]interface{}) (unknownKeys []string, err error) {
+	if toolParameterTypes[toolTypeID] == nil {
+		return
+	}
+	typed, err := decodeToolParameters(toolTypeID, parameters)
+	if err == nil {
+		unknownKeys = unknownToolParameters(typed, parameters)
+		err = typed.Validate()
+	}
+	if err != nil {
+		err = core.SDKErrorf(err, fmt.Sprintf("invalid parameters for tool type '%s': %s", toolTypeID, err.Error()), "tool-parameters-invalid", common.GetComponentInfo())
+	}
+	return
+}
+
+func decodeToolParameters(toolTypeID string, parameters map[string]interface{}) (ToolParameters, error) {
+	newParameters := toolParameterTypes[toolTypeID]
+	if newParameters == nil {
+		return nil, fmt.Errorf("tool type '%s' has no typed parameters", toolTypeID)
+	}
+	typed := newParameters()
+	b, err := json.Marshal(parameters)
+	if err == nil {
+		err = json.Unmarshal(b, typed)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return typed, nil
+}
+
+// unknownToolParameters returns the keys of parameters that the fields of typed do not define, sorted.
+func unknownToolParameters(typed ToolParameters, parameters map[string]interface{}) (unknown []string) {
+	known := map[string]bool{}
+	addToolParameterKeys(reflect.TypeOf(typed).Elem(), known)
+	for key := range parameters {
+		if !known[key] {
+			unknown = append(unknown, key)
+		}
+	}
+	sort.Strings(unknown)
+	return
+}
+
+// addToolParameterKeys adds the JSON names of the fields of structType, including the fields of
+// embedded structs, to keys.
+func addToolParameterKeys(structType reflect.Type, keys map[string]bool) {
+	for i := 0; i < structType.NumField(); i++ {
+		field := structType.Field(i)
+		name := strings.Split(field.Tag.Get("json"), ",")[0]
+		if field.Anonymous && name == "" && field.Type.Kind() == reflect.Struct {
+			addToolParameterKeys(field.Type, keys)
+		} else if name != "" && name != "-" {
+			keys[name] = true
+		}
+	}
+}
+
+// TypedParameters returns the tool's parameters as the typed model of its tool type, for example
+// *GithubConsolidatedToolParameters. An error is returned for tool types without typed parameters.
+func (toolchainTool *ToolchainTool) TypedParameters() (ToolParameters, error) {
+	return ToolParametersFromMap(core.StringNilMapper(toolchainTool.ToolTypeID), toolchainTool.Parameters)
+}
+
+// TypedParameters returns the tool's parameters as the typed model of its tool type, for example
+// *GithubConsolidatedToolParameters. An error is returned for tool types without typed parameters.
+func (toolModel *ToolModel) TypedParameters() (ToolParameters, error) {
+	return ToolParametersFromMap(core.StringNilMapper(toolModel.ToolTypeID), toolModel.Parameters)
+}
+
+// SetTypedParameters : Validate parameters and set ToolTypeID and Parameters from them
+func (_options *CreateToolOptions) SetTypedParameters(parameters ToolParameters) error {
+	parameterMap, err := ToolParametersToMap(parameters)
+	if err != nil {
+		return err
+	}
+	_options.ToolTypeID = core.StringPtr(parameters.ToolTypeID())
+	_options.Parameters = parameterMap
+	return nil
+}
+
+// SetTypedParameters : Validate parameters and set Parameters from them
+func (toolchainToolPrototypePatch *ToolchainToolPrototypePatch) SetTypedParameters(parameters ToolParameters) error {
+	parameterMap, err := ToolParametersToMap(parameters)
+	if err != nil {
+		return err
+	}
+	toolchainToolPrototypePatch.ToolTypeID = core.StringPtr(parameters.ToolTypeID())
+	toolchainToolPrototypePatch.Parameters = parameterMap
+	return nil
+}
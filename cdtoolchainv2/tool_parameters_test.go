@@ -0,0 +1,132 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtoolchainv2_test
+
+import (
+	"encoding/json"
+
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtoolchainv2"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`ToolParameters`, func() {
+	It(`Convert typed parameters to a map`, func() {
+		parameters := &cdtoolchainv2.GithubConsolidatedToolParameters{GitRepoToolParameters: cdtoolchainv2.GitRepoToolParameters{
+			Type:    core.StringPtr(cdtoolchainv2.GitRepoToolParametersTypeLinkConst),
+			RepoURL: core.StringPtr("https://github.com/org/app"),
+			GitID:   core.StringPtr("github"),
+		}}
+		parameterMap, err := cdtoolchainv2.ToolParametersToMap(parameters)
+		Expect(err).To(BeNil())
+		Expect(parameterMap).To(Equal(map[string]interface{}{
+			"type": "link", "repo_url": "https://github.com/org/app", "git_id": "github",
+		}))
+
+		createToolOptions := new(cdtoolchainv2.CdToolchainV2).NewCreateToolOptions("tc1", "placeholder")
+		Expect(createToolOptions.SetTypedParameters(parameters)).To(Succeed())
+		Expect(*createToolOptions.ToolTypeID).To(Equal("githubconsolidated"))
+		Expect(createToolOptions.Parameters).To(Equal(parameterMap))
+
+		patch := &cdtoolchainv2.ToolchainToolPrototypePatch{}
+		Expect(patch.SetTypedParameters(&cdtoolchainv2.PipelineToolParameters{Name: core.StringPtr("ci")})).To(Succeed())
+		Expect(patch.Parameters).To(Equal(map[string]interface{}{"name": "ci"}))
+	})
+	It(`Validate typed parameters`, func() {
+		for _, parameters := range []cdtoolchainv2.ToolParameters{
+			&cdtoolchainv2.GithubConsolidatedToolParameters{GitRepoToolParameters: cdtoolchainv2.GitRepoToolParameters{Type: core.StringPtr("link")}},
+			&cdtoolchainv2.GitlabToolParameters{GitRepoToolParameters: cdtoolchainv2.GitRepoToolParameters{Type: core.StringPtr("copy")}},
+			&cdtoolchainv2.HostedGitToolParameters{GitRepoToolParameters: cdtoolchainv2.GitRepoToolParameters{Type: core.StringPtr("new"), RepoName: core.StringPtr("app"), AuthType: core.StringPtr("pat")}},
+			&cdtoolchainv2.PipelineToolParameters{Name: core.StringPtr("ci"), Type: core.StringPtr("jenkins")},
+			&cdtoolchainv2.SlackToolParameters{ChannelName: core.StringPtr("#builds")},
+			&cdtoolchainv2.SecretsManagerToolParameters{Name: core.StringPtr("sm"), InstanceIDType: core.StringPtr("instance-crn")},
+			&cdtoolchainv2.KeyProtectToolParameters{Name: core.StringPtr("kp")},
+			&cdtoolchainv2.PrivateWorkerToolParameters{Name: core.StringPtr("worker")},
+		} {
+			Expect(parameters.Validate()).ToNot(Succeed(), parameters.ToolTypeID())
+			_, err := cdtoolchainv2.ToolParametersToMap(parameters)
+			Expect(err).ToNot(BeNil())
+		}
+		Expect((&cdtoolchainv2.SecretsManagerToolParameters{
+			Name: core.StringPtr("sm"), InstanceIDType: core.StringPtr("instance-crn"), InstanceCRN: core.StringPtr("crn:v1:sm"),
+		}).Validate()).To(Succeed())
+
+		// The instance name is required unless the instance is identified by its CRN, including
+		// when the instance ID type is omitted.
+		Expect((&cdtoolchainv2.SecretsManagerToolParameters{Name: core.StringPtr("sm")}).Validate()).ToNot(Succeed())
+		Expect((&cdtoolchainv2.SecretsManagerToolParameters{
+			Name: core.StringPtr("sm"), InstanceIDType: core.StringPtr("instance-name"),
+		}).Validate()).ToNot(Succeed())
+		Expect((&cdtoolchainv2.SecretsManagerToolParameters{Name: core.StringPtr("sm"), InstanceName: core.StringPtr("vault")}).Validate()).To(Succeed())
+		Expect((&cdtoolchainv2.SecretsManagerToolParameters{
+			Name: core.StringPtr("sm"), InstanceIDType: core.StringPtr("instance-name"), InstanceName: core.StringPtr("vault"),
+		}).Validate()).To(Succeed())
+		_, err := cdtoolchainv2.ToolParametersToMap(nil)
+		Expect(err).ToNot(BeNil())
+	})
+	It(`Validate parameter maps`, func() {
+		unknownKeys, err := cdtoolchainv2.ValidateToolParameters("slack", map[string]interface{}{"channel_name": "#builds", "webhook": "https://hooks"})
+		Expect(err).To(BeNil())
+		Expect(unknownKeys).To(BeEmpty())
+
+		// Keys that the typed parameters do not define are returned rather than rejected.
+		unknownKeys, err = cdtoolchainv2.ValidateToolParameters("slack", map[string]interface{}{
+			"channel_name": "#builds", "webhook": "https://hooks", "pretext": "CI", "api_token": "x",
+		})
+		Expect(err).To(BeNil())
+		Expect(unknownKeys).To(Equal([]string{"api_token", "pretext"}))
+		unknownKeys, err = cdtoolchainv2.ValidateToolParameters("githubconsolidated", map[string]interface{}{
+			"type": "link", "repo_url": "https://github.com/org/app", "git_id": "github", "toolchain_issues_enabled": true,
+		})
+		Expect(err).To(BeNil())
+		Expect(unknownKeys).To(BeEmpty())
+
+		unknownKeys, err = cdtoolchainv2.ValidateToolParameters("slack", map[string]interface{}{"chanel_name": "#builds", "webhook": "https://hooks"})
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("ChannelName"))
+		Expect(unknownKeys).To(Equal([]string{"chanel_name"}))
+
+		_, err = cdtoolchainv2.ValidateToolParameters("slack", map[string]interface{}{"webhook": "https://hooks"})
+		Expect(err).ToNot(BeNil())
+		unknownKeys, err = cdtoolchainv2.ValidateToolParameters("unknown_tool", map[string]interface{}{"anything": true})
+		Expect(err).To(BeNil())
+		Expect(unknownKeys).To(BeEmpty())
+	})
+	It(`Invoke TypedParameters on tools`, func() {
+		var tool *cdtoolchainv2.ToolchainTool
+		var raw map[string]json.RawMessage
+		Expect(json.Unmarshal([]byte(`{"id": "t1", "tool_type_id": "secretsmanager", "parameters": {
+			"name": "sm", "instance_id_type": "instance-name", "instance_name": "vault", "computed_by_service": 1}}`), &raw)).To(Succeed())
+		Expect(cdtoolchainv2.UnmarshalToolchainTool(raw, &tool)).To(Succeed())
+		parameters, err := tool.TypedParameters()
+		Expect(err).To(BeNil())
+		secretsManager, ok := parameters.(*cdtoolchainv2.SecretsManagerToolParameters)
+		Expect(ok).To(BeTrue())
+		Expect(*secretsManager.InstanceName).To(Equal("vault"))
+		Expect(secretsManager.Validate()).To(Succeed())
+
+		model := &cdtoolchainv2.ToolModel{ToolTypeID: core.StringPtr("pipeline"), Parameters: map[string]interface{}{"name": "ci", "type": "tekton"}}
+		parameters, err = model.TypedParameters()
+		Expect(err).To(BeNil())
+		Expect(*parameters.(*cdtoolchainv2.PipelineToolParameters).Type).To(Equal("tekton"))
+
+		_, err = (&cdtoolchainv2.ToolchainTool{ToolTypeID: core.StringPtr("unknown_tool")}).TypedParameters()
+		Expect(err).ToNot(BeNil())
+		Expect(cdtoolchainv2.TypedToolTypeIDs()).To(ContainElement("githubconsolidated"))
+	})
+})
@@ -0,0 +1,533 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtoolchainv2
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"math"
+	"net/mail"
+	"net/url"
+	"os"
+	"path"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	common "github.com/IBM/continuous-delivery-go-sdk/v2/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// The JSON Schema documents of the tool integrations, one file per tool type named
+// <tool_type_id>.json, and in the shared directory the documents listed by sharedToolSchemas.
+//
+//go:embed schemas/*.json schemas/shared/*.json
+var embeddedToolSchemas embed.FS
+
+// sharedToolSchemas maps the embedded documents that describe several tool types to these types.
+var sharedToolSchemas = map[string][]string{
+	"schemas/shared/git_repo.json": {ToolTypeIDGithubConsolidatedConst, ToolTypeIDGitlabConst, ToolTypeIDHostedGitConst},
+}
+
+// ToolSchemaRegistry : A set of JSON Schema documents describing the parameters of tool
+// integrations, keyed by ToolTypeID. The registry supports the subset of JSON Schema needed to
+// describe tool parameters: type, properties, required, additionalProperties, enum, const,
+// format, pattern, minLength, maxLength, minimum, maximum, items, allOf, anyOf, oneOf, not and
+// if/then/else, and the annotations $schema, $id, $comment, title, description, default,
+// examples, deprecated, readOnly and writeOnly. Schemas using any other keyword are rejected. The
+// formats "uri", "email", "date-time", "uuid" and "crn" are checked; other formats are accepted
+// as is.
+type ToolSchemaRegistry struct {
+	mutex   sync.RWMutex
+	schemas map[string]*toolSchema
+}
+
+// NewToolSchemaRegistry returns an empty registry.
+func NewToolSchemaRegistry() *ToolSchemaRegistry {
+	return &ToolSchemaRegistry{schemas: map[string]*toolSchema{}}
+}
+
+// NewDefaultToolSchemaRegistry returns a registry holding the schemas embedded in this package,
+// which cover the tool types listed by TypedToolTypeIDs. More schemas can be added to it.
+func NewDefaultToolSchemaRegistry() (registry *ToolSchemaRegistry, err error) {
+	registry = NewToolSchemaRegistry()
+	err = registry.LoadFS(embeddedToolSchemas, "schemas/*.json")
+	for name, toolTypeIDs := range sharedToolSchemas {
+		if err != nil {
+			break
+		}
+		var schema []byte
+		if schema, err = embeddedToolSchemas.ReadFile(name); err != nil {
+			err = core.SDKErrorf(err, "", "tool-schema-read-error", common.GetComponentInfo())
+			break
+		}
+		for _, toolTypeID := range toolTypeIDs {
+			if err = registry.Register(toolTypeID, schema); err != nil {
+				break
+			}
+		}
+	}
+	if err != nil {
+		registry = nil
+	}
+	return
+}
+
+// Register adds or replaces the schema of toolTypeID.
+func (registry *ToolSchemaRegistry) Register(toolTypeID string, schema []byte) error {
+	var parsed *toolSchema
+	err := json.Unmarshal(schema, &parsed)
+	if err == nil && parsed == nil {
+		err = fmt.Errorf("the schema is null")
+	}
+	if err == nil {
+		err = parsed.compile("#")
+	}
+	if err != nil {
+		return core.SDKErrorf(err, fmt.Sprintf("invalid schema for tool type '%s': %s", toolTypeID, err.Error()), "tool-schema-invalid", common.GetComponentInfo())
+	}
+	registry.mutex.Lock()
+	defer registry.mutex.Unlock()
+	registry.schemas[toolTypeID] = parsed
+	return nil
+}
+
+// LoadFS registers every file of fsys matching pattern, as accepted by fs.Glob. The tool type of
+// each schema is its file name without the extension.
+func (registry *ToolSchemaRegistry) LoadFS(fsys fs.FS, pattern string) error {
+	names, err := fs.Glob(fsys, pattern)
+	if err != nil {
+		return core.SDKErrorf(err, "", "tool-schema-pattern-invalid", common.GetComponentInfo())
+	}
+	for _, name := range names {
+		schema, err := fs.ReadFile(fsys, name)
+		if err != nil {
+			return core.SDKErrorf(err, "", "tool-schema-read-error", common.GetComponentInfo())
+		}
+		base := path.Base(name)
+		if err = registry.Register(strings.TrimSuffix(base, path.Ext(base)), schema); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// LoadDir registers every .json file of dir, as LoadFS does.
+func (registry *ToolSchemaRegistry) LoadDir(dir string) error {
+	return registry.LoadFS(os.DirFS(dir), "*.json")
+}
+
+// ToolTypeIDs returns the tool types that have a schema, sorted.
+func (registry *ToolSchemaRegistry) ToolTypeIDs() []string {
+	registry.mutex.RLock()
+	defer registry.mutex.RUnlock()
+	ids := make([]string, 0, len(registry.schemas))
+	for id := range registry.schemas {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// ValidateParameters checks parameters against the schema of toolTypeID. Tool types without a
+// schema are not checked. The returned error wraps a *ToolSchemaValidationError listing every issue.
+func (registry *ToolSchemaRegistry) ValidateParameters(toolTypeID string, parameters map[string]interface{}) error {
+	return registry.validate(toolTypeID, parameters, false)
+}
+
+// ValidateCreateToolOptions checks the parameters of a CreateTool request.
+func (registry *ToolSchemaRegistry) ValidateCreateToolOptions(createToolOptions *CreateToolOptions) error {
+	err := core.ValidateNotNil(createToolOptions, "createToolOptions cannot be nil")
+	if err != nil {
+		return core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+	}
+	return registry.validate(core.StringNilMapper(createToolOptions.ToolTypeID), createToolOptions.Parameters, false)
+}
+
+// ValidateUpdateToolOptions checks the parameters of an UpdateTool request. An update only
+// changes the parameters it lists, so required parameters are not enforced. currentToolTypeID is
+// used when the patch does not change the tool type.
+func (registry *ToolSchemaRegistry) ValidateUpdateToolOptions(updateToolOptions *UpdateToolOptions, currentToolTypeID string) error {
+	err := core.ValidateNotNil(updateToolOptions, "updateToolOptions cannot be nil")
+	if err != nil {
+		return core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+	}
+	var patch struct {
+		ToolTypeID *string                `json:"tool_type_id"`
+		Parameters map[string]interface{} `json:"parameters"`
+	}
+	b, err := json.Marshal(updateToolOptions.ToolchainToolPrototypePatch)
+	if err == nil {
+		err = json.Unmarshal(b, &patch)
+	}
+	if err != nil {
+		return core.SDKErrorf(err, "", "tool-patch-invalid", common.GetComponentInfo())
+	}
+	if patch.ToolTypeID != nil {
+		currentToolTypeID = *patch.ToolTypeID
+	}
+	if patch.Parameters == nil {
+		return nil
+	}
+	return registry.validate(currentToolTypeID, patch.Parameters, true)
+}
+
+func (registry *ToolSchemaRegistry) validate(toolTypeID string, parameters map[string]interface{}, partial bool) error {
+	registry.mutex.RLock()
+	schema := registry.schemas[toolTypeID]
+	registry.mutex.RUnlock()
+	if schema == nil {
+		return nil
+	}
+
+	// Normalize Go values such as *string or []string to their JSON form.
+	var instance interface{} = map[string]interface{}{}
+	if parameters != nil {
+		b, err := json.Marshal(parameters)
+		if err == nil {
+			err = json.Unmarshal(b, &instance)
+		}
+		if err != nil {
+			return core.SDKErrorf(err, "", "tool-parameters-conversion-error", common.GetComponentInfo())
+		}
+	}
+	validation := &ToolSchemaValidationError{ToolTypeID: toolTypeID}
+	validation.Issues = schema.validate(instance, "parameters", partial)
+	if len(validation.Issues) > 0 {
+		return core.SDKErrorf(validation, "", "tool-schema-validation-failed", common.GetComponentInfo())
+	}
+	return nil
+}
+
+// ToolSchemaIssue : A schema violation.
+type ToolSchemaIssue struct {
+	// The location of the violation, for example "parameters.repo_url" or "parameters.owners[1]".
+	Path string `json:"path"`
+
+	Message string `json:"message"`
+}
+
+// ToolSchemaValidationError : The issues found by a ToolSchemaRegistry validation. Use errors.As
+// to obtain it from the error returned by the validation methods.
+type ToolSchemaValidationError struct {
+	ToolTypeID string            `json:"tool_type_id"`
+	Issues     []ToolSchemaIssue `json:"issues"`
+}
+
+// Error implements the error interface.
+func (validation *ToolSchemaValidationError) Error() string {
+	issues := make([]string, len(validation.Issues))
+	for i, issue := range validation.Issues {
+		issues[i] = issue.Path + ": " + issue.Message
+	}
+	return fmt.Sprintf("invalid parameters for tool type '%s': %s", validation.ToolTypeID, strings.Join(issues, "; "))
+}
+
+// toolSchema is the parsed form of a JSON Schema document.
+type toolSchema struct {
+	Type                 schemaTypes            `json:"type"`
+	Properties           map[string]*toolSchema `json:"properties"`
+	Required             []string               `json:"required"`
+	AdditionalProperties *bool                  `json:"additionalProperties"`
+	Enum                 []interface{}          `json:"enum"`
+	Const                *json.RawMessage       `json:"const"`
+	Format               string                 `json:"format"`
+	Pattern              string                 `json:"pattern"`
+	MinLength            *int                   `json:"minLength"`
+	MaxLength            *int                   `json:"maxLength"`
+	Minimum              *float64               `json:"minimum"`
+	Maximum              *float64               `json:"maximum"`
+	Items                *toolSchema            `json:"items"`
+	AllOf                []*toolSchema          `json:"allOf"`
+	AnyOf                []*toolSchema          `json:"anyOf"`
+	OneOf                []*toolSchema          `json:"oneOf"`
+	Not                  *toolSchema            `json:"not"`
+	If                   *toolSchema            `json:"if"`
+	Then                 *toolSchema            `json:"then"`
+	Else                 *toolSchema            `json:"else"`
+
+	keywords []string
+	pattern  *regexp.Regexp
+	value    interface{}
+}
+
+// supportedSchemaKeywords are the keywords that a toolSchema validates or ignores as annotations.
+var supportedSchemaKeywords = map[string]bool{
+	"type": true, "properties": true, "required": true, "additionalProperties": true, "enum": true,
+	"const": true, "format": true, "pattern": true, "minLength": true, "maxLength": true,
+	"minimum": true, "maximum": true, "items": true, "allOf": true, "anyOf": true, "oneOf": true,
+	"not": true, "if": true, "then": true, "else": true,
+	"$schema": true, "$id": true, "$comment": true, "title": true, "description": true,
+	"default": true, "examples": true, "deprecated": true, "readOnly": true, "writeOnly": true,
+}
+
+// UnmarshalJSON records the keywords of the schema so that compile can reject the unsupported ones.
+func (schema *toolSchema) UnmarshalJSON(data []byte) error {
+	type plainToolSchema toolSchema
+	if err := json.Unmarshal(data, (*plainToolSchema)(schema)); err != nil {
+		return err
+	}
+	var keywords map[string]json.RawMessage
+	if err := json.Unmarshal(data, &keywords); err != nil {
+		return err
+	}
+	schema.keywords = make([]string, 0, len(keywords))
+	for keyword := range keywords {
+		schema.keywords = append(schema.keywords, keyword)
+	}
+	sort.Strings(schema.keywords)
+	return nil
+}
+
+// schemaTypes accepts both forms of the "type" keyword: a string or a list of strings.
+type schemaTypes []string
+
+func (types *schemaTypes) UnmarshalJSON(data []byte) error {
+	var single string
+	if json.Unmarshal(data, &single) == nil {
+		*types = schemaTypes{single}
+		return nil
+	}
+	var list []string
+	if err := json.Unmarshal(data, &list); err != nil {
+		return fmt.Errorf("'type' must be a string or a list of strings")
+	}
+	*types = list
+	return nil
+}
+
+// compile checks the keywords that cannot be validated by unmarshaling and prepares patterns.
+func (schema *toolSchema) compile(location string) (err error) {
+	if schema == nil {
+		return nil
+	}
+	for _, keyword := range schema.keywords {
+		if !supportedSchemaKeywords[keyword] {
+			return fmt.Errorf("%s: '%s' is not supported", location, keyword)
+		}
+	}
+	if schema.Pattern != "" {
+		if schema.pattern, err = regexp.Compile(schema.Pattern); err != nil {
+			return fmt.Errorf("%s: invalid pattern: %s", location, err.Error())
+		}
+	}
+	if schema.Const != nil {
+		if err = json.Unmarshal(*schema.Const, &schema.value); err != nil {
+			return fmt.Errorf("%s: invalid const: %s", location, err.Error())
+		}
+	}
+	for name, property := range schema.Properties {
+		if err = property.compile(location + "/properties/" + name); err != nil {
+			return
+		}
+	}
+	for keyword, list := range map[string][]*toolSchema{"allOf": schema.AllOf, "anyOf": schema.AnyOf, "oneOf": schema.OneOf} {
+		for i, subschema := range list {
+			if err = subschema.compile(fmt.Sprintf("%s/%s/%d", location, keyword, i)); err != nil {
+				return
+			}
+		}
+	}
+	for keyword, subschema := range map[string]*toolSchema{"items": schema.Items, "not": schema.Not, "if": schema.If, "then": schema.Then, "else": schema.Else} {
+		if err = subschema.compile(location + "/" + keyword); err != nil {
+			return
+		}
+	}
+	return nil
+}
+
+// validate returns the violations of instance, a value decoded from JSON, located at location.
+// In partial mode, required properties are not enforced.
+func (schema *toolSchema) validate(instance interface{}, location string, partial bool) (issues []ToolSchemaIssue) {
+	if schema == nil {
+		return nil
+	}
+	fail := func(format string, args ...interface{}) {
+		issues = append(issues, ToolSchemaIssue{Path: location, Message: fmt.Sprintf(format, args...)})
+	}
+
+	if len(schema.Type) > 0 && !matchesType(schema.Type, instance) {
+		fail("must be of type %s", strings.Join(schema.Type, " or "))
+		return
+	}
+	if schema.Enum != nil && !containsValue(schema.Enum, instance) {
+		fail("must be one of %s", formatValues(schema.Enum))
+	}
+	if schema.Const != nil && !reflect.DeepEqual(schema.value, instance) {
+		fail("must be %s", formatValues([]interface{}{schema.value}))
+	}
+
+	switch value := instance.(type) {
+	case string:
+		length := utf8.RuneCountInString(value)
+		if schema.MinLength != nil && length < *schema.MinLength {
+			fail("must be at least %d characters long", *schema.MinLength)
+		}
+		if schema.MaxLength != nil && length > *schema.MaxLength {
+			fail("must be at most %d characters long", *schema.MaxLength)
+		}
+		if schema.pattern != nil && !schema.pattern.MatchString(value) {
+			fail("must match the pattern '%s'", schema.Pattern)
+		}
+		if schema.Format != "" && !validFormat(schema.Format, value) {
+			fail("must be a valid %s", schema.Format)
+		}
+	case float64:
+		if schema.Minimum != nil && value < *schema.Minimum {
+			fail("must be at least %v", *schema.Minimum)
+		}
+		if schema.Maximum != nil && value > *schema.Maximum {
+			fail("must be at most %v", *schema.Maximum)
+		}
+	case []interface{}:
+		for i, item := range value {
+			issues = append(issues, schema.Items.validate(item, fmt.Sprintf("%s[%d]", location, i), partial)...)
+		}
+	case map[string]interface{}:
+		if !partial {
+			for _, name := range schema.Required {
+				if _, ok := value[name]; !ok {
+					issues = append(issues, ToolSchemaIssue{Path: location + "." + name, Message: "is required"})
+				}
+			}
+		}
+		names := make([]string, 0, len(value))
+		for name := range value {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			property, defined := schema.Properties[name]
+			if !defined {
+				if schema.AdditionalProperties != nil && !*schema.AdditionalProperties {
+					issues = append(issues, ToolSchemaIssue{Path: location + "." + name, Message: "is not a known parameter"})
+				}
+				continue
+			}
+			issues = append(issues, property.validate(value[name], location+"."+name, partial)...)
+		}
+	}
+
+	for _, subschema := range schema.AllOf {
+		issues = append(issues, subschema.validate(instance, location, partial)...)
+	}
+	if len(schema.AnyOf) > 0 && schema.countMatches(schema.AnyOf, instance, partial) == 0 {
+		fail("must match at least one of the allowed schemas")
+	}
+	if len(schema.OneOf) > 0 && schema.countMatches(schema.OneOf, instance, partial) != 1 {
+		fail("must match exactly one of the allowed schemas")
+	}
+	if schema.Not != nil && len(schema.Not.validate(instance, location, partial)) == 0 {
+		fail("must not match the excluded schema")
+	}
+	if schema.If != nil {
+		// The condition is evaluated with required properties enforced, so that an absent
+		// property never selects a branch.
+		if len(schema.If.validate(instance, location, false)) == 0 {
+			issues = append(issues, schema.Then.validate(instance, location, partial)...)
+		} else {
+			issues = append(issues, schema.Else.validate(instance, location, partial)...)
+		}
+	}
+	return
+}
+
+func (schema *toolSchema) countMatches(subschemas []*toolSchema, instance interface{}, partial bool) (count int) {
+	for _, subschema := range subschemas {
+		if len(subschema.validate(instance, "", partial)) == 0 {
+			count++
+		}
+	}
+	return
+}
+
+func matchesType(types []string, instance interface{}) bool {
+	for _, name := range types {
+		switch value := instance.(type) {
+		case nil:
+			if name == "null" {
+				return true
+			}
+		case bool:
+			if name == "boolean" {
+				return true
+			}
+		case string:
+			if name == "string" {
+				return true
+			}
+		case float64:
+			if name == "number" || (name == "integer" && value == math.Trunc(value)) {
+				return true
+			}
+		case []interface{}:
+			if name == "array" {
+				return true
+			}
+		case map[string]interface{}:
+			if name == "object" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func containsValue(values []interface{}, instance interface{}) bool {
+	for _, value := range values {
+		if reflect.DeepEqual(value, instance) {
+			return true
+		}
+	}
+	return false
+}
+
+func formatValues(values []interface{}) string {
+	formatted := make([]string, len(values))
+	for i, value := range values {
+		b, _ := json.Marshal(value)
+		formatted[i] = string(b)
+	}
+	return strings.Join(formatted, ", ")
+}
+
+var uuidRegexp = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+func validFormat(format string, value string) bool {
+	switch format {
+	case "uri", "url":
+		parsed, err := url.Parse(value)
+		return err == nil && parsed.Scheme != "" && parsed.Host != ""
+	case "email":
+		_, err := mail.ParseAddress(value)
+		return err == nil
+	case "date-time":
+		_, err := time.Parse(time.RFC3339, value)
+		return err == nil
+	case "uuid":
+		return uuidRegexp.MatchString(value)
+	case "crn":
+		return strings.HasPrefix(value, "crn:") && strings.Count(value, ":") == 9
+	}
+	return true
+}
@@ -0,0 +1,172 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtoolchainv2_test
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtoolchainv2"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`ToolSchemaRegistry`, func() {
+	var registry *cdtoolchainv2.ToolSchemaRegistry
+
+	issuesOf := func(err error) []cdtoolchainv2.ToolSchemaIssue {
+		var validation *cdtoolchainv2.ToolSchemaValidationError
+		Expect(errors.As(err, &validation)).To(BeTrue())
+		return validation.Issues
+	}
+
+	BeforeEach(func() {
+		var err error
+		registry, err = cdtoolchainv2.NewDefaultToolSchemaRegistry()
+		Expect(err).To(BeNil())
+	})
+
+	It(`Load the embedded schemas`, func() {
+		Expect(registry.ToolTypeIDs()).To(Equal(cdtoolchainv2.TypedToolTypeIDs()))
+	})
+	It(`Agree with the typed parameters`, func() {
+		// Each fixture is valid or invalid for both the schemas and ValidateToolParameters.
+		fixtures := []struct {
+			toolTypeID string
+			parameters map[string]interface{}
+			valid      bool
+		}{
+			{"githubconsolidated", map[string]interface{}{"type": "link", "repo_url": "https://github.com/org/app", "title": "App"}, true},
+			{"gitlab", map[string]interface{}{"type": "new", "repo_name": "app", "auth_type": "pat", "api_token": "x"}, true},
+			{"hostedgit", map[string]interface{}{"type": "clone", "source_repo_url": "https://us-south.git.cloud.ibm.com/org/app"}, true},
+			{"githubconsolidated", map[string]interface{}{"type": "link"}, false},
+			{"gitlab", map[string]interface{}{"type": "fork"}, false},
+			{"hostedgit", map[string]interface{}{"type": "new", "repo_name": "app", "auth_type": "pat"}, false},
+			{"hostedgit", map[string]interface{}{"type": "mirror"}, false},
+			{"pipeline", map[string]interface{}{"name": "ci", "type": "tekton", "ui_pipeline": false}, true},
+			{"pipeline", map[string]interface{}{"name": "ci", "type": "jenkins"}, false},
+			{"slack", map[string]interface{}{"channel_name": "#builds", "webhook": "https://hooks", "pretext": "CI"}, true},
+			{"slack", map[string]interface{}{"webhook": "https://hooks", "pretext": "CI"}, false},
+			{"secretsmanager", map[string]interface{}{"name": "sm", "instance_name": "vault", "instance-type": "standard"}, true},
+			{"secretsmanager", map[string]interface{}{"name": "sm", "instance_id_type": "instance-crn", "instance_crn": "crn:v1:bluemix:public:secrets-manager:us-south:a/1:2::"}, true},
+			{"secretsmanager", map[string]interface{}{"name": "sm", "instance_id_type": "instance-crn"}, false},
+			{"secretsmanager", map[string]interface{}{"name": "sm"}, false},
+			{"keyprotect", map[string]interface{}{"name": "kp", "instance_name": "keys", "location": "us-south", "resource_group_name": "default"}, true},
+			{"keyprotect", map[string]interface{}{"name": "kp", "instance_name": "keys"}, false},
+			{"private_worker", map[string]interface{}{"name": "worker", "worker_queue_credentials": "key", "worker_queue_identifier": "q1"}, true},
+			{"private_worker", map[string]interface{}{"name": "worker"}, false},
+		}
+		for _, fixture := range fixtures {
+			schemaErr := registry.ValidateParameters(fixture.toolTypeID, fixture.parameters)
+			_, typedErr := cdtoolchainv2.ValidateToolParameters(fixture.toolTypeID, fixture.parameters)
+			Expect(schemaErr == nil).To(Equal(fixture.valid), "%s %v: %v", fixture.toolTypeID, fixture.parameters, schemaErr)
+			Expect(typedErr == nil).To(Equal(fixture.valid), "%s %v: %v", fixture.toolTypeID, fixture.parameters, typedErr)
+		}
+	})
+	It(`Validate CreateTool options`, func() {
+		options := new(cdtoolchainv2.CdToolchainV2).NewCreateToolOptions("tc1", "githubconsolidated")
+		options.SetParameters(map[string]interface{}{"type": "link", "repo_url": "https://github.com/org/app", "private_repo": true})
+		Expect(registry.ValidateCreateToolOptions(options)).To(Succeed())
+
+		options.SetParameters(map[string]interface{}{"type": "mirror", "repo_ur": "x", "private_repo": "yes", "auth_type": "pat"})
+		err := registry.ValidateCreateToolOptions(options)
+		Expect(err).ToNot(BeNil())
+		Expect(issuesOf(err)).To(Equal([]cdtoolchainv2.ToolSchemaIssue{
+			{Path: "parameters.private_repo", Message: "must be of type boolean"},
+			{Path: "parameters.type", Message: `must be one of "new", "fork", "clone", "link"`},
+			{Path: "parameters.api_token", Message: "is required"},
+		}))
+		Expect(err.Error()).To(ContainSubstring("parameters.api_token: is required"))
+
+		options.SetParameters(map[string]interface{}{"type": "fork", "source_repo_url": "not a url"})
+		Expect(issuesOf(registry.ValidateCreateToolOptions(options))).To(Equal([]cdtoolchainv2.ToolSchemaIssue{
+			{Path: "parameters.source_repo_url", Message: "must be a valid uri"},
+		}))
+
+		options.SetToolTypeID("unknown_tool")
+		Expect(registry.ValidateCreateToolOptions(options)).To(Succeed())
+		Expect(registry.ValidateCreateToolOptions(nil)).ToNot(Succeed())
+	})
+	It(`Validate UpdateTool options`, func() {
+		patch := &cdtoolchainv2.ToolchainToolPrototypePatch{Parameters: map[string]interface{}{"pipeline_fail": true}}
+		patchMap, err := patch.AsPatch()
+		Expect(err).To(BeNil())
+		options := new(cdtoolchainv2.CdToolchainV2).NewUpdateToolOptions("tc1", "t1", patchMap)
+		Expect(registry.ValidateUpdateToolOptions(options, "slack")).To(Succeed())
+
+		patch = &cdtoolchainv2.ToolchainToolPrototypePatch{ToolTypeID: core.StringPtr("secretsmanager"), Parameters: map[string]interface{}{"instance_crn": "crn:bad"}}
+		patchMap, err = patch.AsPatch()
+		Expect(err).To(BeNil())
+		options.SetToolchainToolPrototypePatch(patchMap)
+		Expect(issuesOf(registry.ValidateUpdateToolOptions(options, "slack"))).To(Equal([]cdtoolchainv2.ToolSchemaIssue{
+			{Path: "parameters.instance_crn", Message: "must be a valid crn"},
+		}))
+	})
+	It(`Validate nested values with precise paths`, func() {
+		Expect(registry.Register("custom", []byte(`{
+			"type": "object",
+			"required": ["owners"],
+			"properties": {
+				"owners": {"type": "array", "items": {"type": "string", "pattern": "^[a-z]+$"}},
+				"port": {"type": "integer", "minimum": 1, "maximum": 65535},
+				"mode": {"oneOf": [{"const": "a"}, {"const": "b"}]},
+				"contact": {"type": "object", "additionalProperties": false, "properties": {"email": {"type": "string", "format": "email"}}}
+			}}`))).To(Succeed())
+		err := registry.ValidateParameters("custom", map[string]interface{}{
+			"owners":  []string{"alice", "Bob"},
+			"port":    70000,
+			"mode":    "c",
+			"contact": map[string]interface{}{"email": "nope", "phone": "1"},
+		})
+		Expect(issuesOf(err)).To(Equal([]cdtoolchainv2.ToolSchemaIssue{
+			{Path: "parameters.contact.email", Message: "must be a valid email"},
+			{Path: "parameters.contact.phone", Message: "is not a known parameter"},
+			{Path: "parameters.mode", Message: "must match exactly one of the allowed schemas"},
+			{Path: "parameters.owners[1]", Message: "must match the pattern '^[a-z]+$'"},
+			{Path: "parameters.port", Message: "must be at most 65535"},
+		}))
+		Expect(issuesOf(registry.ValidateParameters("custom", nil))).To(Equal([]cdtoolchainv2.ToolSchemaIssue{
+			{Path: "parameters.owners", Message: "is required"},
+		}))
+	})
+	It(`Load user-supplied schemas`, func() {
+		dir, err := os.MkdirTemp("", "schemas")
+		Expect(err).To(BeNil())
+		defer os.RemoveAll(dir)
+		Expect(os.WriteFile(filepath.Join(dir, "artifactory.json"), []byte(`{"type": "object", "required": ["dashboard_url"]}`), 0600)).To(Succeed())
+		Expect(registry.LoadDir(dir)).To(Succeed())
+		Expect(registry.ToolTypeIDs()).To(ContainElement("artifactory"))
+		Expect(registry.ValidateParameters("artifactory", map[string]interface{}{})).ToNot(Succeed())
+
+		Expect(os.WriteFile(filepath.Join(dir, "broken.json"), []byte(`{"properties": {"x": {"$ref": "#/definitions/x"}}}`), 0600)).To(Succeed())
+		err = registry.LoadDir(dir)
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("broken"))
+		Expect(registry.Register("bad", []byte(`{"pattern": "("}`))).ToNot(Succeed())
+		Expect(registry.Register("bad", []byte(`{"type": 1}`))).ToNot(Succeed())
+		for _, keyword := range []string{"minItems", "uniqueItems", "patternProperties", "exclusiveMinimum", "dependentRequired", "propertyNames"} {
+			err = registry.Register("bad", []byte(fmt.Sprintf(`{"type": "object", "properties": {"x": {"%s": 1}}}`, keyword)))
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("#/properties/x: '%s' is not supported", keyword))
+		}
+		Expect(registry.Register("annotated", []byte(`{"$schema": "http://json-schema.org/draft-07/schema#", "title": "Annotated", "properties": {"x": {"description": "X", "default": "x"}}}`))).To(Succeed())
+		Expect(registry.ToolTypeIDs()).ToNot(ContainElement("bad"))
+	})
+})
@@ -0,0 +1,531 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtoolchainv2
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	common "github.com/IBM/continuous-delivery-go-sdk/v2/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// ToolchainEventContentMaxDepth is the maximum depth of the JSON content of an event, as
+// documented by the API.
+const ToolchainEventContentMaxDepth = 5
+
+// Constants associated with the "event_type" field of the JSON content produced by the event builders.
+const (
+	ToolchainEventTypeDeploymentStartedConst   = "deployment_started"
+	ToolchainEventTypeDeploymentFinishedConst  = "deployment_finished"
+	ToolchainEventTypeApprovalRequestedConst   = "approval_requested"
+	ToolchainEventTypeSecurityFindingConst     = "security_finding"
+	ToolchainEventTypePipelineRunFinishedConst = "pipeline_run_finished"
+)
+
+// toolchainEventSchemaVersion is the "schema_version" of the JSON content produced by the event builders.
+const toolchainEventSchemaVersion = "1"
+
+var toolchainEventKeyRegexp = regexp.MustCompile(`^[a-zA-Z0-9-_]+$`)
+
+// ToolchainEvent : An event to send with CreateToolchainEvent. At most one of Content and Text may
+// be set; the content type is selected accordingly.
+type ToolchainEvent struct {
+	// Event title.
+	Title string
+
+	// Describes the event.
+	Description string
+
+	// JSON data, sent with the `application/json` content type.
+	Content map[string]interface{}
+
+	// Text data, sent with the `text/plain` content type.
+	Text string
+}
+
+// NewToolchainEvent returns an event without data.
+func NewToolchainEvent(title string, description string) *ToolchainEvent {
+	return &ToolchainEvent{Title: title, Description: description}
+}
+
+// ContentType returns the content type matching the event's data.
+func (event *ToolchainEvent) ContentType() string {
+	switch {
+	case event.Content != nil:
+		return CreateToolchainEventOptionsContentTypeApplicationJSONConst
+	case event.Text != "":
+		return CreateToolchainEventOptionsContentTypeTextPlainConst
+	}
+	return CreateToolchainEventOptionsContentTypeNoneConst
+}
+
+// Validate checks that the event has a title and a description and at most one kind of data and,
+// for JSON content, the maximum depth and key format documented by the API.
+func (event *ToolchainEvent) Validate() error {
+	var problems []string
+	if event.Title == "" || event.Description == "" {
+		problems = append(problems, "title and description must be set")
+	}
+	if event.Content != nil && event.Text != "" {
+		problems = append(problems, "only one of content and text may be set")
+	}
+	if event.Content != nil {
+		// The content is checked in its JSON form, so that typed values such as structs or
+		// map[string]string are checked like the maps and slices they are sent as.
+		var decoded interface{}
+		b, err := json.Marshal(event.Content)
+		if err == nil {
+			err = json.Unmarshal(b, &decoded)
+		}
+		if err != nil {
+			problems = append(problems, "content cannot be serialized: "+err.Error())
+		}
+		problems = append(problems, checkEventContent(decoded, "content", 1)...)
+	}
+	if len(problems) > 0 {
+		return core.SDKErrorf(nil, "invalid toolchain event: "+strings.Join(problems, "; "), "toolchain-event-invalid", common.GetComponentInfo())
+	}
+	return nil
+}
+
+// checkEventContent checks the key format and depth of JSON content decoded into an interface{},
+// where depth is the nesting level of value.
+func checkEventContent(value interface{}, location string, depth int) (problems []string) {
+	switch value := value.(type) {
+	case map[string]interface{}:
+		if depth > ToolchainEventContentMaxDepth {
+			return []string{fmt.Sprintf("%s exceeds the maximum depth of %d", location, ToolchainEventContentMaxDepth)}
+		}
+		keys := make([]string, 0, len(value))
+		for key := range value {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			if !toolchainEventKeyRegexp.MatchString(key) {
+				problems = append(problems, fmt.Sprintf("%s has the invalid key '%s'", location, key))
+			}
+			problems = append(problems, checkEventContent(value[key], location+"."+key, depth+1)...)
+		}
+	case []interface{}:
+		for i, item := range value {
+			problems = append(problems, checkEventContent(item, fmt.Sprintf("%s[%d]", location, i), depth)...)
+		}
+	}
+	return
+}
+
+// Options validates the event and returns the CreateToolchainEvent options that send it to toolchainID.
+func (event *ToolchainEvent) Options(toolchainID string) (options *CreateToolchainEventOptions, err error) {
+	if err = event.Validate(); err != nil {
+		return
+	}
+	options = &CreateToolchainEventOptions{
+		ToolchainID: core.StringPtr(toolchainID),
+		Title:       core.StringPtr(event.Title),
+		Description: core.StringPtr(event.Description),
+		ContentType: core.StringPtr(event.ContentType()),
+	}
+	switch *options.ContentType {
+	case CreateToolchainEventOptionsContentTypeApplicationJSONConst:
+		options.Data = &ToolchainEventPrototypeData{ApplicationJSON: &ToolchainEventPrototypeDataApplicationJSON{Content: event.Content}}
+	case CreateToolchainEventOptionsContentTypeTextPlainConst:
+		options.Data = &ToolchainEventPrototypeData{TextPlain: &ToolchainEventPrototypeDataTextPlain{Content: core.StringPtr(event.Text)}}
+	}
+	return
+}
+
+// PublishToolchainEvent invokes PublishToolchainEventWithContext using context.Background().
+func (cdToolchain *CdToolchainV2) PublishToolchainEvent(toolchainID string, event *ToolchainEvent) (result *ToolchainEventPost, response *core.DetailedResponse, err error) {
+	result, response, err = cdToolchain.PublishToolchainEventWithContext(context.Background(), toolchainID, event)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// PublishToolchainEventWithContext validates event and sends it with CreateToolchainEventWithContext.
+func (cdToolchain *CdToolchainV2) PublishToolchainEventWithContext(ctx context.Context, toolchainID string, event *ToolchainEvent) (result *ToolchainEventPost, response *core.DetailedResponse, err error) {
+	err = core.ValidateNotNil(event, "event cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	options, err := event.Options(toolchainID)
+	if err != nil {
+		return
+	}
+	return cdToolchain.CreateToolchainEventWithContext(ctx, options)
+}
+
+// eventContent returns the JSON content shared by the event builders, with the details of the
+// event under section. Empty values are omitted from details.
+func eventContent(eventType string, timestamp time.Time, section string, details map[string]interface{}) map[string]interface{} {
+	for key, value := range details {
+		switch value := value.(type) {
+		case string:
+			if value == "" {
+				delete(details, key)
+			}
+		case []string:
+			if len(value) == 0 {
+				delete(details, key)
+			}
+		case map[string]interface{}:
+			if len(value) == 0 {
+				delete(details, key)
+			}
+		case nil:
+			delete(details, key)
+		}
+	}
+	return map[string]interface{}{
+		"event_type":     eventType,
+		"schema_version": toolchainEventSchemaVersion,
+		"timestamp":      timestamp.UTC().Format(time.RFC3339),
+		section:          details,
+	}
+}
+
+func formatEventTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+// Constants associated with the DeploymentEvent.Status property.
+const (
+	DeploymentEventStatusSucceededConst = "succeeded"
+	DeploymentEventStatusFailedConst    = "failed"
+	DeploymentEventStatusCanceledConst  = "canceled"
+)
+
+// DeploymentEvent : The details of a deployment, for NewDeploymentStartedEvent and NewDeploymentFinishedEvent.
+type DeploymentEvent struct {
+	// The deployed application. Required.
+	Application string
+
+	// The target environment. Required.
+	Environment string
+
+	// The deployed version.
+	Version string
+
+	// An identifier of the deployment, for example a pipeline run ID.
+	DeploymentID string
+
+	// A link to the deployment, for example a pipeline run URL.
+	URL string
+
+	// The start time. Defaults to the current time for a started event.
+	StartedAt time.Time
+
+	// The end time of a finished deployment. Defaults to the current time.
+	FinishedAt time.Time
+
+	// One of the DeploymentEventStatus constants. Required for a finished event.
+	Status string
+
+	// Additional details, with keys matching ^[a-zA-Z0-9-_]+$.
+	Details map[string]interface{}
+}
+
+func (deployment *DeploymentEvent) subject() string {
+	subject := deployment.Application
+	if deployment.Version != "" {
+		subject += " " + deployment.Version
+	}
+	return subject + " to " + deployment.Environment
+}
+
+func (deployment *DeploymentEvent) content(eventType string, timestamp time.Time) map[string]interface{} {
+	return eventContent(eventType, timestamp, "deployment", map[string]interface{}{
+		"application":   deployment.Application,
+		"environment":   deployment.Environment,
+		"version":       deployment.Version,
+		"deployment_id": deployment.DeploymentID,
+		"url":           deployment.URL,
+		"started_at":    formatEventTime(deployment.StartedAt),
+		"finished_at":   formatEventTime(deployment.FinishedAt),
+		"status":        deployment.Status,
+		"details":       deployment.Details,
+	})
+}
+
+// NewDeploymentStartedEvent returns the event announcing the start of a deployment.
+func NewDeploymentStartedEvent(deployment DeploymentEvent) (event *ToolchainEvent, err error) {
+	if deployment.Application == "" || deployment.Environment == "" {
+		err = core.SDKErrorf(nil, "the deployment's Application and Environment must be set", "toolchain-event-missing-required", common.GetComponentInfo())
+		return
+	}
+	if deployment.StartedAt.IsZero() {
+		deployment.StartedAt = time.Now()
+	}
+	deployment.FinishedAt, deployment.Status = time.Time{}, ""
+	event = &ToolchainEvent{
+		Title:       "Deployment of " + deployment.subject() + " started",
+		Description: fmt.Sprintf("The deployment of %s started at %s.", deployment.subject(), formatEventTime(deployment.StartedAt)),
+		Content:     deployment.content(ToolchainEventTypeDeploymentStartedConst, deployment.StartedAt),
+	}
+	return
+}
+
+// NewDeploymentFinishedEvent returns the event announcing the outcome of a deployment. When
+// StartedAt is set, the content includes the duration in seconds.
+func NewDeploymentFinishedEvent(deployment DeploymentEvent) (event *ToolchainEvent, err error) {
+	if deployment.Application == "" || deployment.Environment == "" || deployment.Status == "" {
+		err = core.SDKErrorf(nil, "the deployment's Application, Environment and Status must be set", "toolchain-event-missing-required", common.GetComponentInfo())
+		return
+	}
+	if deployment.FinishedAt.IsZero() {
+		deployment.FinishedAt = time.Now()
+	}
+	description := fmt.Sprintf("The deployment of %s %s at %s", deployment.subject(), deployment.Status, formatEventTime(deployment.FinishedAt))
+	content := deployment.content(ToolchainEventTypeDeploymentFinishedConst, deployment.FinishedAt)
+	if !deployment.StartedAt.IsZero() {
+		duration := deployment.FinishedAt.Sub(deployment.StartedAt).Round(time.Second)
+		content["deployment"].(map[string]interface{})["duration_seconds"] = int64(duration.Seconds())
+		description += fmt.Sprintf(" after %s", duration)
+	}
+	event = &ToolchainEvent{
+		Title:       "Deployment of " + deployment.subject() + " " + deployment.Status,
+		Description: description + ".",
+		Content:     content,
+	}
+	return
+}
+
+// ApprovalRequest : The details of an approval request, for NewApprovalRequestedEvent.
+type ApprovalRequest struct {
+	// What needs approval, for example "Release 1.4.0 to production". Required.
+	Subject string
+
+	// The user or automation asking for approval.
+	Requester string
+
+	// The users or groups that can approve.
+	Approvers []string
+
+	// Why the approval is needed.
+	Reason string
+
+	// Where to approve or reject.
+	URL string
+
+	// When the request expires, if ever.
+	ExpiresAt time.Time
+
+	// Additional details, with keys matching ^[a-zA-Z0-9-_]+$.
+	Details map[string]interface{}
+}
+
+// NewApprovalRequestedEvent returns the event asking for an approval.
+func NewApprovalRequestedEvent(request ApprovalRequest) (event *ToolchainEvent, err error) {
+	if request.Subject == "" {
+		err = core.SDKErrorf(nil, "the approval request's Subject must be set", "toolchain-event-missing-required", common.GetComponentInfo())
+		return
+	}
+	description := "Approval requested for " + request.Subject
+	if request.Requester != "" {
+		description += " by " + request.Requester
+	}
+	if request.Reason != "" {
+		description += ": " + request.Reason
+	}
+	if request.URL != "" {
+		description += ". Respond at " + request.URL
+	}
+	event = &ToolchainEvent{
+		Title:       "Approval requested: " + request.Subject,
+		Description: description + ".",
+		Content: eventContent(ToolchainEventTypeApprovalRequestedConst, time.Now(), "approval", map[string]interface{}{
+			"subject":    request.Subject,
+			"requester":  request.Requester,
+			"approvers":  request.Approvers,
+			"reason":     request.Reason,
+			"url":        request.URL,
+			"expires_at": formatEventTime(request.ExpiresAt),
+			"details":    request.Details,
+		}),
+	}
+	return
+}
+
+// Constants associated with the SecurityFinding.Severity property.
+const (
+	SecurityFindingSeverityCriticalConst = "critical"
+	SecurityFindingSeverityHighConst     = "high"
+	SecurityFindingSeverityMediumConst   = "medium"
+	SecurityFindingSeverityLowConst      = "low"
+	SecurityFindingSeverityInfoConst     = "info"
+)
+
+// SecurityFinding : The details of a security finding, for NewSecurityFindingEvent.
+type SecurityFinding struct {
+	// Short name of the finding. Required.
+	Title string
+
+	// One of the SecurityFindingSeverity constants. Required.
+	Severity string
+
+	// The affected component, for example an image or a package. Required.
+	Component string
+
+	// The affected version of the component.
+	Version string
+
+	// The scanner that reported the finding.
+	Scanner string
+
+	// Identifiers of the vulnerability, for example CVE numbers.
+	Identifiers []string
+
+	// Details of the finding.
+	Description string
+
+	// A link to the finding.
+	URL string
+
+	// Additional details, with keys matching ^[a-zA-Z0-9-_]+$.
+	Details map[string]interface{}
+}
+
+// NewSecurityFindingEvent returns the event reporting a security finding.
+func NewSecurityFindingEvent(finding SecurityFinding) (event *ToolchainEvent, err error) {
+	if finding.Title == "" || finding.Component == "" {
+		err = core.SDKErrorf(nil, "the finding's Title and Component must be set", "toolchain-event-missing-required", common.GetComponentInfo())
+		return
+	}
+	switch finding.Severity {
+	case SecurityFindingSeverityCriticalConst, SecurityFindingSeverityHighConst, SecurityFindingSeverityMediumConst, SecurityFindingSeverityLowConst, SecurityFindingSeverityInfoConst:
+	default:
+		err = core.SDKErrorf(nil, fmt.Sprintf("invalid severity '%s'", finding.Severity), "toolchain-event-invalid-severity", common.GetComponentInfo())
+		return
+	}
+	component := finding.Component
+	if finding.Version != "" {
+		component += " " + finding.Version
+	}
+	description := fmt.Sprintf("A %s severity finding was reported in %s", finding.Severity, component)
+	if finding.Scanner != "" {
+		description += " by " + finding.Scanner
+	}
+	if len(finding.Identifiers) > 0 {
+		description += " (" + strings.Join(finding.Identifiers, ", ") + ")"
+	}
+	event = &ToolchainEvent{
+		Title:       fmt.Sprintf("[%s] %s in %s", strings.ToUpper(finding.Severity), finding.Title, component),
+		Description: description + ".",
+		Content: eventContent(ToolchainEventTypeSecurityFindingConst, time.Now(), "finding", map[string]interface{}{
+			"title":       finding.Title,
+			"severity":    finding.Severity,
+			"component":   finding.Component,
+			"version":     finding.Version,
+			"scanner":     finding.Scanner,
+			"identifiers": finding.Identifiers,
+			"description": finding.Description,
+			"url":         finding.URL,
+			"details":     finding.Details,
+		}),
+	}
+	return
+}
+
+// PipelineRunOutcome : The details of a finished pipeline run, for NewPipelineRunFinishedEvent.
+type PipelineRunOutcome struct {
+	// The pipeline ID. Required.
+	PipelineID string
+
+	// The pipeline name.
+	PipelineName string
+
+	// The pipeline run ID. Required.
+	RunID string
+
+	// The build number of the run, if known.
+	BuildNumber int64
+
+	// The final status of the run, for example "succeeded" or "failed". Required.
+	Status string
+
+	// The URL of the run's details page.
+	URL string
+
+	// The creation time of the run.
+	StartedAt time.Time
+
+	// The end time of the run. Defaults to the current time.
+	FinishedAt time.Time
+
+	// The error message reported for the run.
+	ErrorMessage string
+}
+
+// NewPipelineRunFinishedEvent returns the event announcing the outcome of a pipeline run. When
+// StartedAt is set, the content includes the duration in seconds.
+func NewPipelineRunFinishedEvent(outcome PipelineRunOutcome) (event *ToolchainEvent, err error) {
+	if outcome.PipelineID == "" || outcome.RunID == "" || outcome.Status == "" {
+		err = core.SDKErrorf(nil, "the outcome's PipelineID, RunID and Status must be set", "toolchain-event-missing-required", common.GetComponentInfo())
+		return
+	}
+	if outcome.FinishedAt.IsZero() {
+		outcome.FinishedAt = time.Now()
+	}
+	subject := "Pipeline run " + outcome.RunID
+	if outcome.BuildNumber > 0 {
+		subject = fmt.Sprintf("Pipeline run #%d", outcome.BuildNumber)
+	}
+	pipeline := outcome.PipelineName
+	if pipeline == "" {
+		pipeline = outcome.PipelineID
+	}
+	subject += " of " + pipeline
+	details := map[string]interface{}{
+		"pipeline_id":   outcome.PipelineID,
+		"pipeline_name": outcome.PipelineName,
+		"run_id":        outcome.RunID,
+		"status":        outcome.Status,
+		"url":           outcome.URL,
+		"started_at":    formatEventTime(outcome.StartedAt),
+		"finished_at":   formatEventTime(outcome.FinishedAt),
+		"error_message": outcome.ErrorMessage,
+	}
+	if outcome.BuildNumber > 0 {
+		details["build_number"] = outcome.BuildNumber
+	}
+	description := fmt.Sprintf("%s %s at %s", subject, outcome.Status, formatEventTime(outcome.FinishedAt))
+	if !outcome.StartedAt.IsZero() {
+		duration := outcome.FinishedAt.Sub(outcome.StartedAt).Round(time.Second)
+		details["duration_seconds"] = int64(duration.Seconds())
+		description += fmt.Sprintf(" after %s", duration)
+	}
+	description += "."
+	if outcome.URL != "" {
+		description += " Details at " + outcome.URL + "."
+	}
+	event = &ToolchainEvent{
+		Title:       subject + " " + outcome.Status,
+		Description: description,
+		Content:     eventContent(ToolchainEventTypePipelineRunFinishedConst, outcome.FinishedAt, "pipeline_run", details),
+	}
+	return
+}
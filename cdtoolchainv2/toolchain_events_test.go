@@ -0,0 +1,175 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtoolchainv2_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtoolchainv2"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`ToolchainEvent builders`, func() {
+	started := time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC)
+
+	It(`Build deployment events`, func() {
+		event, err := cdtoolchainv2.NewDeploymentStartedEvent(cdtoolchainv2.DeploymentEvent{
+			Application: "shop", Environment: "prod", Version: "1.4.0", StartedAt: started, Details: map[string]interface{}{"region": "us-south"},
+		})
+		Expect(err).To(BeNil())
+		Expect(event.Title).To(Equal("Deployment of shop 1.4.0 to prod started"))
+		Expect(event.ContentType()).To(Equal("application/json"))
+		Expect(event.Content).To(Equal(map[string]interface{}{
+			"event_type":     "deployment_started",
+			"schema_version": "1",
+			"timestamp":      "2025-03-01T10:00:00Z",
+			"deployment": map[string]interface{}{
+				"application": "shop", "environment": "prod", "version": "1.4.0",
+				"started_at": "2025-03-01T10:00:00Z", "details": map[string]interface{}{"region": "us-south"},
+			},
+		}))
+
+		event, err = cdtoolchainv2.NewDeploymentFinishedEvent(cdtoolchainv2.DeploymentEvent{
+			Application: "shop", Environment: "prod", StartedAt: started, FinishedAt: started.Add(90 * time.Second),
+			Status: cdtoolchainv2.DeploymentEventStatusFailedConst, URL: "https://run",
+		})
+		Expect(err).To(BeNil())
+		Expect(event.Title).To(Equal("Deployment of shop to prod failed"))
+		Expect(event.Description).To(Equal("The deployment of shop to prod failed at 2025-03-01T10:01:30Z after 1m30s."))
+		deployment := event.Content["deployment"].(map[string]interface{})
+		Expect(deployment["duration_seconds"]).To(Equal(int64(90)))
+		Expect(deployment["url"]).To(Equal("https://run"))
+		Expect(event.Validate()).To(Succeed())
+
+		_, err = cdtoolchainv2.NewDeploymentStartedEvent(cdtoolchainv2.DeploymentEvent{Application: "shop"})
+		Expect(err).ToNot(BeNil())
+		_, err = cdtoolchainv2.NewDeploymentFinishedEvent(cdtoolchainv2.DeploymentEvent{Application: "shop", Environment: "prod"})
+		Expect(err).ToNot(BeNil())
+	})
+	It(`Build approval and security finding events`, func() {
+		event, err := cdtoolchainv2.NewApprovalRequestedEvent(cdtoolchainv2.ApprovalRequest{
+			Subject: "Release 1.4.0 to production", Requester: "release-bot", Approvers: []string{"ops"}, URL: "https://approve",
+		})
+		Expect(err).To(BeNil())
+		Expect(event.Title).To(Equal("Approval requested: Release 1.4.0 to production"))
+		Expect(event.Description).To(Equal("Approval requested for Release 1.4.0 to production by release-bot. Respond at https://approve."))
+		Expect(event.Content["approval"]).To(HaveKeyWithValue("approvers", []string{"ops"}))
+		Expect(event.Content["approval"]).ToNot(HaveKey("reason"))
+		_, err = cdtoolchainv2.NewApprovalRequestedEvent(cdtoolchainv2.ApprovalRequest{})
+		Expect(err).ToNot(BeNil())
+
+		event, err = cdtoolchainv2.NewSecurityFindingEvent(cdtoolchainv2.SecurityFinding{
+			Title: "Remote code execution", Severity: "critical", Component: "openssl", Version: "3.0.1", Scanner: "trivy", Identifiers: []string{"CVE-2025-0001"},
+		})
+		Expect(err).To(BeNil())
+		Expect(event.Title).To(Equal("[CRITICAL] Remote code execution in openssl 3.0.1"))
+		Expect(event.Description).To(Equal("A critical severity finding was reported in openssl 3.0.1 by trivy (CVE-2025-0001)."))
+		Expect(event.Content["event_type"]).To(Equal("security_finding"))
+		_, err = cdtoolchainv2.NewSecurityFindingEvent(cdtoolchainv2.SecurityFinding{Title: "x", Component: "y", Severity: "severe"})
+		Expect(err).ToNot(BeNil())
+	})
+	It(`Build pipeline run events`, func() {
+		event, err := cdtoolchainv2.NewPipelineRunFinishedEvent(cdtoolchainv2.PipelineRunOutcome{
+			PipelineID: "p1", RunID: "r1", Status: "succeeded", FinishedAt: started,
+		})
+		Expect(err).To(BeNil())
+		Expect(event.Title).To(Equal("Pipeline run r1 of p1 succeeded"))
+		Expect(event.Description).To(Equal("Pipeline run r1 of p1 succeeded at 2025-03-01T10:00:00Z."))
+		Expect(event.Content["pipeline_run"]).ToNot(HaveKey("build_number"))
+		Expect(event.Content["pipeline_run"]).ToNot(HaveKey("duration_seconds"))
+		_, err = cdtoolchainv2.NewPipelineRunFinishedEvent(cdtoolchainv2.PipelineRunOutcome{PipelineID: "p1", RunID: "r1"})
+		Expect(err).ToNot(BeNil())
+	})
+	It(`Validate events`, func() {
+		Expect(cdtoolchainv2.NewToolchainEvent("title", "description").ContentType()).To(Equal("none"))
+		Expect(cdtoolchainv2.NewToolchainEvent("", "description").Validate()).ToNot(Succeed())
+
+		event := &cdtoolchainv2.ToolchainEvent{Title: "t", Description: "d", Text: "x"}
+		Expect(event.ContentType()).To(Equal("text/plain"))
+		Expect(event.Validate()).To(Succeed())
+		event.Content = map[string]interface{}{"a": 1}
+		Expect(event.Validate()).ToNot(Succeed())
+
+		event = &cdtoolchainv2.ToolchainEvent{Title: "t", Description: "d", Content: map[string]interface{}{
+			"bad key": 1,
+			"a":       map[string]interface{}{"b": map[string]interface{}{"c": map[string]interface{}{"d": map[string]interface{}{"e": map[string]interface{}{"f": 1}}}}},
+		}}
+		err := event.Validate()
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("content has the invalid key 'bad key'"))
+		Expect(err.Error()).To(ContainSubstring("content.a.b.c.d.e exceeds the maximum depth of 5"))
+		event.Content = map[string]interface{}{"a": map[string]interface{}{"b": map[string]interface{}{"c": map[string]interface{}{"d": map[string]interface{}{"e": 1}}}}}
+		Expect(event.Validate()).To(Succeed())
+
+		// Typed values are checked in their JSON form.
+		event.Content = map[string]interface{}{
+			"labels":  map[string]map[string]string{"team": {"bad key": "x"}},
+			"reviews": []map[string]interface{}{{"a": map[string]interface{}{"b": map[string]interface{}{"c": map[string]interface{}{"d": map[string]int{"e": 1}}}}}},
+		}
+		err = event.Validate()
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("content.labels.team has the invalid key 'bad key'"))
+		Expect(err.Error()).To(ContainSubstring("content.reviews[0].a.b.c.d exceeds the maximum depth of 5"))
+		event, err = cdtoolchainv2.NewDeploymentStartedEvent(cdtoolchainv2.DeploymentEvent{
+			Application: "app", Environment: "prod",
+			Details: map[string]interface{}{"regions": map[string][]string{"bad key": {"us-south"}}},
+		})
+		Expect(err).To(BeNil())
+		err = event.Validate()
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("content.deployment.details.regions has the invalid key 'bad key'"))
+	})
+	It(`Invoke PublishToolchainEvent`, func() {
+		var bodies []map[string]interface{}
+		testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+			Expect(req.URL.EscapedPath()).To(Equal("/toolchains/tc1/events"))
+			var body map[string]interface{}
+			Expect(json.NewDecoder(req.Body).Decode(&body)).To(Succeed())
+			bodies = append(bodies, body)
+			res.Header().Set("Content-type", "application/json")
+			res.WriteHeader(202)
+			fmt.Fprint(res, `{"id": "e1"}`)
+		}))
+		defer testServer.Close()
+		cdToolchainService, err := cdtoolchainv2.NewCdToolchainV2(&cdtoolchainv2.CdToolchainV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+
+		result, _, err := cdToolchainService.PublishToolchainEvent("tc1", &cdtoolchainv2.ToolchainEvent{Title: "t", Description: "d", Text: "hello"})
+		Expect(err).To(BeNil())
+		Expect(*result.ID).To(Equal("e1"))
+		Expect(bodies[0]).To(Equal(map[string]interface{}{
+			"title": "t", "description": "d", "content_type": "text/plain",
+			"data": map[string]interface{}{"text_plain": map[string]interface{}{"content": "hello"}},
+		}))
+
+		_, _, err = cdToolchainService.PublishToolchainEvent("tc1", &cdtoolchainv2.ToolchainEvent{Title: "t", Description: "d", Content: map[string]interface{}{"a b": 1}})
+		Expect(err).ToNot(BeNil())
+		_, _, err = cdToolchainService.PublishToolchainEvent("tc1", nil)
+		Expect(err).ToNot(BeNil())
+		Expect(bodies).To(HaveLen(1))
+	})
+})
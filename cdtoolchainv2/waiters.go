@@ -0,0 +1,113 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtoolchainv2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	common "github.com/IBM/continuous-delivery-go-sdk/v2/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// WaitForToolStateOptions : The WaitForToolState options.
+type WaitForToolStateOptions struct {
+	// ID of the toolchain.
+	ToolchainID string
+
+	// ID of the tool.
+	ToolID string
+
+	// The time between two GetToolByID calls. Defaults to common.DefaultWaitInterval.
+	PollInterval time.Duration
+
+	// The maximum time to wait. Defaults to common.DefaultWaitTimeout.
+	Timeout time.Duration
+}
+
+// ToolStateError : The error returned by WaitForToolState when a tool settles in the
+// `misconfigured` or `unconfigured` state. Use errors.As to obtain it.
+type ToolStateError struct {
+	Tool *ToolchainTool
+}
+
+// Error implements the error interface.
+func (toolStateError *ToolStateError) Error() string {
+	tool := toolStateError.Tool
+	message := fmt.Sprintf("tool '%s' (%s, type '%s') in toolchain '%s' is %s",
+		core.StringNilMapper(tool.Name), core.StringNilMapper(tool.ID), core.StringNilMapper(tool.ToolTypeID),
+		core.StringNilMapper(tool.ToolchainID), core.StringNilMapper(tool.State))
+	switch core.StringNilMapper(tool.State) {
+	case ToolchainToolStateMisconfiguredConst:
+		message += ": the tool integration could not be set up with its current parameters, check them and update the tool"
+	case ToolchainToolStateUnconfiguredConst:
+		message += ": the tool integration has not been set up yet, provide its required parameters with UpdateTool"
+	}
+	return message
+}
+
+// WaitForToolState invokes WaitForToolStateWithContext using context.Background().
+func (cdToolchain *CdToolchainV2) WaitForToolState(options *WaitForToolStateOptions) (tool *ToolchainTool, err error) {
+	tool, err = cdToolchain.WaitForToolStateWithContext(context.Background(), options)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// WaitForToolStateWithContext polls GetToolByID, bypassing the ResponseCache of the service
+// instance, while the tool is in the `configuring` state. It returns the tool once it is
+// `configured`. If the tool settles in the `misconfigured` or `unconfigured` state, the tool is
+// returned together with an error wrapping a *ToolStateError.
+func (cdToolchain *CdToolchainV2) WaitForToolStateWithContext(ctx context.Context, options *WaitForToolStateOptions) (tool *ToolchainTool, err error) {
+	err = core.ValidateNotNil(options, "options cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	if options.ToolchainID == "" || options.ToolID == "" {
+		err = core.SDKErrorf(nil, "the 'options.ToolchainID' and 'options.ToolID' fields must be set", "missing-required-option", common.GetComponentInfo())
+		return
+	}
+	interval, timeout := options.PollInterval, options.Timeout
+	if interval <= 0 {
+		interval = common.DefaultWaitInterval
+	}
+	if timeout <= 0 {
+		timeout = common.DefaultWaitTimeout
+	}
+	waitCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	getToolByIDOptions := cdToolchain.NewGetToolByIDOptions(options.ToolchainID, options.ToolID)
+	err = common.PollUntil(waitCtx, interval, func(ctx context.Context) (done bool, err error) {
+		tool, _, err = cdToolchain.GetToolByIDWithContext(common.WithoutResponseCache(ctx), getToolByIDOptions)
+		if err != nil {
+			return
+		}
+		return core.StringNilMapper(tool.State) != ToolchainToolStateConfiguringConst, nil
+	})
+	switch {
+	case errors.Is(err, context.DeadlineExceeded) && ctx.Err() == nil:
+		err = core.SDKErrorf(err, fmt.Sprintf("timed out after %s waiting for tool '%s' to leave the '%s' state", timeout, options.ToolID, ToolchainToolStateConfiguringConst), "wait-timeout", common.GetComponentInfo())
+	case err != nil:
+		err = core.SDKErrorf(err, "", "wait-error", common.GetComponentInfo())
+	case core.StringNilMapper(tool.State) != ToolchainToolStateConfiguredConst:
+		err = core.SDKErrorf(&ToolStateError{Tool: tool}, "", "tool-not-configured", common.GetComponentInfo())
+	}
+	return
+}
@@ -0,0 +1,124 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtoolchainv2_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"time"
+
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtoolchainv2"
+	common "github.com/IBM/continuous-delivery-go-sdk/v2/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`WaitForToolState`, func() {
+	var (
+		testServer         *httptest.Server
+		cdToolchainService *cdtoolchainv2.CdToolchainV2
+		calls              int32
+		states             []string
+	)
+
+	BeforeEach(func() {
+		atomic.StoreInt32(&calls, 0)
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+			Expect(req.URL.EscapedPath()).To(Equal("/toolchains/tc1/tools/t1"))
+			call := int(atomic.AddInt32(&calls, 1)) - 1
+			state := states[len(states)-1]
+			if call < len(states) {
+				state = states[call]
+			}
+			res.Header().Set("Content-type", "application/json")
+			fmt.Fprintf(res, `{"id": "t1", "name": "repo", "tool_type_id": "githubconsolidated", "toolchain_id": "tc1", "state": "%s"}`, state)
+		}))
+		var err error
+		cdToolchainService, err = cdtoolchainv2.NewCdToolchainV2(&cdtoolchainv2.CdToolchainV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	options := &cdtoolchainv2.WaitForToolStateOptions{ToolchainID: "tc1", ToolID: "t1", PollInterval: time.Millisecond}
+
+	It(`Wait until the tool is configured`, func() {
+		states = []string{"configuring", "configuring", "configured"}
+		tool, err := cdToolchainService.WaitForToolState(options)
+		Expect(err).To(BeNil())
+		Expect(*tool.State).To(Equal("configured"))
+		Expect(atomic.LoadInt32(&calls)).To(Equal(int32(3)))
+	})
+	It(`Bypass the response cache while waiting`, func() {
+		states = []string{"configuring", "configuring", "configured"}
+		cdToolchainService, err := cdtoolchainv2.NewCdToolchainV2(&cdtoolchainv2.CdToolchainV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+			ResponseCache: common.NewResponseCache(&common.ResponseCacheOptions{TTL: time.Hour}),
+		})
+		Expect(err).To(BeNil())
+		tool, _, err := cdToolchainService.GetToolByID(cdToolchainService.NewGetToolByIDOptions("tc1", "t1"))
+		Expect(err).To(BeNil())
+		Expect(*tool.State).To(Equal("configuring"))
+
+		tool, err = cdToolchainService.WaitForToolState(options)
+		Expect(err).To(BeNil())
+		Expect(*tool.State).To(Equal("configured"))
+		Expect(atomic.LoadInt32(&calls)).To(Equal(int32(3)))
+
+		// The cache holds the last state seen by the waiter.
+		tool, _, err = cdToolchainService.GetToolByID(cdToolchainService.NewGetToolByIDOptions("tc1", "t1"))
+		Expect(err).To(BeNil())
+		Expect(*tool.State).To(Equal("configured"))
+		Expect(atomic.LoadInt32(&calls)).To(Equal(int32(3)))
+	})
+	It(`Report misconfigured and unconfigured tools`, func() {
+		for _, state := range []string{"misconfigured", "unconfigured"} {
+			states = []string{"configuring", state}
+			tool, err := cdToolchainService.WaitForToolState(options)
+			Expect(err).ToNot(BeNil())
+			Expect(*tool.State).To(Equal(state))
+			var toolStateError *cdtoolchainv2.ToolStateError
+			Expect(errors.As(err, &toolStateError)).To(BeTrue())
+			Expect(toolStateError.Tool).To(Equal(tool))
+			Expect(err.Error()).To(ContainSubstring("tool 'repo' (t1, type 'githubconsolidated') in toolchain 'tc1' is " + state))
+		}
+	})
+	It(`Time out while the tool is configuring`, func() {
+		states = []string{"configuring"}
+		_, err := cdToolchainService.WaitForToolState(&cdtoolchainv2.WaitForToolStateOptions{
+			ToolchainID: "tc1", ToolID: "t1", PollInterval: time.Millisecond, Timeout: 20 * time.Millisecond,
+		})
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("timed out after 20ms"))
+	})
+	It(`Invoke WaitForToolState with invalid options`, func() {
+		_, err := cdToolchainService.WaitForToolState(nil)
+		Expect(err).ToNot(BeNil())
+		_, err = cdToolchainService.WaitForToolState(&cdtoolchainv2.WaitForToolStateOptions{ToolchainID: "tc1"})
+		Expect(err).ToNot(BeNil())
+	})
+})
@@ -0,0 +1,197 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// The error classes of failed API requests, matched with errors.Is against the errors returned by
+// the operations of the service clients.
+var (
+	// The resource does not exist (status 404).
+	ErrNotFound = errors.New("not found")
+
+	// The request conflicts with the state of the resource, for example a duplicate name (status 409).
+	ErrConflict = errors.New("conflict")
+
+	// The resource is locked (status 423, or an error code mentioning a lock).
+	ErrLocked = errors.New("locked")
+
+	// Too many requests were sent (status 429). APIError.RetryAfter holds the requested delay.
+	ErrRateLimited = errors.New("rate limited")
+
+	// The request is invalid (status 400 or 422). APIError.Fields holds the details reported per field.
+	ErrValidation = errors.New("validation failed")
+)
+
+// FieldError : An error reported by the service about one field of a request.
+type FieldError struct {
+	// The name of the field, if the service reported it.
+	Field string
+
+	// The error code.
+	Code string
+
+	// The error message.
+	Message string
+}
+
+// APIError : The details of a failed API request, extracted from the response. An APIError takes
+// the place of the core.HTTPProblem in the chain of the error returned by an operation, and
+// behaves like it: errors.As still finds the core.HTTPProblem, and the messages and problem ID
+// of the error are unchanged. It wraps the original error returned by core.BaseService.Request,
+// so errors.As also finds the problem of the core. Use errors.As to retrieve the APIError, or
+// errors.Is to test its class.
+type APIError struct {
+	*core.HTTPProblem
+
+	// The error class: one of ErrNotFound, ErrConflict, ErrLocked, ErrRateLimited and
+	// ErrValidation, or nil if the status code is not classified.
+	Kind error
+
+	// The HTTP status code.
+	StatusCode int
+
+	// The code of the first error of the response body.
+	Code string
+
+	// The trace identifier of the request, to quote when contacting support.
+	Trace string
+
+	// The errors of the response body, with the field they concern if any.
+	Fields []FieldError
+
+	// The delay requested by the Retry-After header, if any.
+	RetryAfter time.Duration
+
+	// The original error returned by core.BaseService.Request, if known.
+	err error
+}
+
+// Is reports whether target is the class of the error, or the same problem as the underlying
+// core.HTTPProblem.
+func (e *APIError) Is(target error) bool {
+	return (e.Kind != nil && target == e.Kind) || e.HTTPProblem.Is(target)
+}
+
+// Unwrap returns the underlying core.HTTPProblem and the original error.
+func (e *APIError) Unwrap() []error {
+	if e.err == nil {
+		return []error{e.HTTPProblem}
+	}
+	return []error{e.HTTPProblem, e.err}
+}
+
+// apiErrorBody is the error response body of the services.
+type apiErrorBody struct {
+	Trace  string `json:"trace"`
+	Errors []struct {
+		Code    string `json:"code"`
+		Message string `json:"message"`
+		Target  *struct {
+			Name string `json:"name"`
+		} `json:"target"`
+	} `json:"errors"`
+}
+
+// httpRequestErrDiscriminator is the discriminator of the problems that the service clients create
+// for failed requests.
+const httpRequestErrDiscriminator = "http-request-err"
+
+// ClassifyError adds an APIError to the problem that a service client creates, with the
+// "http-request-err" discriminator, for an error returned by core.BaseService.Request because of an
+// error response, and returns err unchanged otherwise. The APIError replaces the core.HTTPProblem
+// as the cause of the problem and wraps the original error, so the problem keeps the problem of the
+// core and its debug information.
+//
+// Every operation of the service clients calls ClassifyError on the problem it creates. The core
+// library builds the core.HTTPProblem after the response went through the transport chain, and
+// offers no hook to replace it, so the call cannot be moved out of the operations: the generator
+// templates of this SDK must emit it, and TestGeneratedOperationsClassifyErrors fails if a generated
+// operation lacks it.
+func ClassifyError(err error) error {
+	sdkProblem, ok := err.(*core.SDKProblem)
+	if !ok {
+		return err
+	}
+	// A problem is classified only once.
+	var httpProblem *core.HTTPProblem
+	causedBy := sdkProblem.GetCausedBy()
+	if _, classified := causedBy.(*APIError); classified || causedBy == nil || !errors.As(causedBy, &httpProblem) || httpProblem.Response == nil {
+		return err
+	}
+	apiError := NewAPIError(httpProblem)
+	for _, cause := range sdkProblem.IBMProblem.Unwrap() {
+		if original, ok := cause.(*core.SDKProblem); ok {
+			apiError.err = original
+			break
+		}
+	}
+	sdkProblem.IBMProblem = core.IBMErrorf(apiError, sdkProblem.Component, sdkProblem.Summary, httpRequestErrDiscriminator)
+	return sdkProblem
+}
+
+// NewAPIError extracts the details of an error response from an HTTPProblem.
+func NewAPIError(httpProblem *core.HTTPProblem) *APIError {
+	response := httpProblem.Response
+	apiError := &APIError{HTTPProblem: httpProblem, StatusCode: response.StatusCode}
+	if response.Headers != nil {
+		apiError.RetryAfter, _ = RetryAfter(response.Headers, time.Now())
+	}
+	var body apiErrorBody
+	if result, ok := response.Result.(map[string]interface{}); ok {
+		if b, err := json.Marshal(result); err == nil && json.Unmarshal(b, &body) == nil {
+			apiError.Trace = body.Trace
+			for _, e := range body.Errors {
+				fieldError := FieldError{Code: e.Code, Message: e.Message}
+				if e.Target != nil {
+					fieldError.Field = e.Target.Name
+				}
+				apiError.Fields = append(apiError.Fields, fieldError)
+			}
+		}
+		if len(apiError.Fields) > 0 {
+			apiError.Code = apiError.Fields[0].Code
+		} else if code, ok := result["code"].(string); ok {
+			apiError.Code = code
+		}
+	}
+
+	switch response.StatusCode {
+	case http.StatusNotFound:
+		apiError.Kind = ErrNotFound
+	case http.StatusLocked:
+		apiError.Kind = ErrLocked
+	case http.StatusConflict:
+		apiError.Kind = ErrConflict
+	case http.StatusTooManyRequests:
+		apiError.Kind = ErrRateLimited
+	case http.StatusBadRequest, http.StatusUnprocessableEntity:
+		apiError.Kind = ErrValidation
+	}
+	if apiError.Kind != ErrNotFound && apiError.Kind != ErrRateLimited && strings.Contains(strings.ToLower(apiError.Code), "lock") {
+		apiError.Kind = ErrLocked
+	}
+	return apiError
+}
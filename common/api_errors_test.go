@@ -0,0 +1,129 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func newHTTPProblem(statusCode int, result map[string]interface{}, headers http.Header) *core.HTTPProblem {
+	return &core.HTTPProblem{
+		IBMProblem: core.IBMErrorf(nil, core.NewProblemComponent("test", "1.0.0"), "request failed", ""),
+		Response:   &core.DetailedResponse{StatusCode: statusCode, Result: result, Headers: headers},
+	}
+}
+
+func TestNewAPIError(t *testing.T) {
+	apiError := NewAPIError(newHTTPProblem(400, map[string]interface{}{
+		"trace": "t1",
+		"errors": []interface{}{
+			map[string]interface{}{"code": "missing_field", "message": "name is required", "target": map[string]interface{}{"name": "name", "type": "field"}},
+			map[string]interface{}{"code": "invalid_value", "message": "bad type"},
+		},
+	}, nil))
+	assert.Equal(t, ErrValidation, apiError.Kind)
+	assert.Equal(t, "missing_field", apiError.Code)
+	assert.Equal(t, "t1", apiError.Trace)
+	assert.Equal(t, []FieldError{
+		{Field: "name", Code: "missing_field", Message: "name is required"},
+		{Code: "invalid_value", Message: "bad type"},
+	}, apiError.Fields)
+	assert.True(t, errors.Is(apiError, ErrValidation))
+	assert.False(t, errors.Is(apiError, ErrNotFound))
+	assert.Equal(t, "request failed", apiError.Error())
+	var httpProblem *core.HTTPProblem
+	assert.True(t, errors.As(apiError, &httpProblem))
+
+	for statusCode, kind := range map[int]error{404: ErrNotFound, 409: ErrConflict, 423: ErrLocked, 422: ErrValidation, 500: nil} {
+		assert.Equal(t, kind, NewAPIError(newHTTPProblem(statusCode, nil, nil)).Kind, statusCode)
+	}
+	apiError = NewAPIError(newHTTPProblem(400, map[string]interface{}{"code": "property_locked"}, nil))
+	assert.Equal(t, ErrLocked, apiError.Kind)
+
+	apiError = NewAPIError(newHTTPProblem(429, nil, http.Header{"Retry-After": []string{"7"}}))
+	assert.True(t, errors.Is(apiError, ErrRateLimited))
+	assert.Equal(t, 7*time.Second, apiError.RetryAfter)
+}
+
+func TestClassifyError(t *testing.T) {
+	assert.Nil(t, ClassifyError(nil))
+	failure := errors.New("failure")
+	assert.Equal(t, failure, ClassifyError(failure))
+
+	server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		res.Header().Set("Content-type", "application/json")
+		res.WriteHeader(404)
+		fmt.Fprint(res, `{"errors": [{"code": "not_found", "message": "Toolchain not found"}]}`)
+	}))
+	defer server.Close()
+	service, err := core.NewBaseService(&core.ServiceOptions{URL: server.URL, Authenticator: &core.NoAuthAuthenticator{}})
+	assert.Nil(t, err)
+	builder := core.NewRequestBuilder(core.GET)
+	_, err = builder.ResolveRequestURL(server.URL, "/toolchains/missing", nil)
+	assert.Nil(t, err)
+	request, err := builder.Build()
+	assert.Nil(t, err)
+	_, original := service.Request(request, nil)
+	assert.NotNil(t, original)
+	core.EnrichHTTPProblem(original, "get_toolchain_by_id", core.NewProblemComponent("test", "1.0.0"))
+
+	unclassified := core.SDKErrorf(original, "", "http-request-err", GetComponentInfo())
+	unclassifiedID := unclassified.GetID()
+	classified := ClassifyError(core.SDKErrorf(original, "", "http-request-err", GetComponentInfo()))
+	var apiError *APIError
+	assert.True(t, errors.As(classified, &apiError))
+	assert.True(t, errors.Is(classified, ErrNotFound))
+	assert.Equal(t, "Toolchain not found", classified.Error())
+
+	// The problem keeps its ID, its cause and the problem of the core.
+	var sdkProblem *core.SDKProblem
+	assert.True(t, errors.As(classified, &sdkProblem))
+	assert.Equal(t, unclassifiedID, sdkProblem.GetID())
+	var httpProblem *core.HTTPProblem
+	assert.True(t, errors.As(classified, &httpProblem))
+	assert.Equal(t, apiError.HTTPProblem, httpProblem)
+	assert.True(t, errors.Is(classified, original))
+	assert.Contains(t, sdkProblem.GetDebugMessage(), "core_problem")
+	var coreProblem *core.SDKProblem
+	assert.True(t, errors.As(apiError, &coreProblem))
+	assert.Equal(t, original, coreProblem)
+
+	// A problem is classified once.
+	assert.Equal(t, classified, ClassifyError(classified))
+	assert.Equal(t, apiError, classified.(*core.SDKProblem).GetCausedBy())
+}
+
+func TestGeneratedOperationsClassifyErrors(t *testing.T) {
+	// The generator templates must emit the ClassifyError call; this fails if a regeneration drops it.
+	for _, file := range []string{"../cdtoolchainv2/cd_toolchain_v2.go", "../cdtektonpipelinev2/cd_tekton_pipeline_v2.go"} {
+		source, err := os.ReadFile(file)
+		assert.Nil(t, err)
+		requests := strings.Count(string(source), ".Service.Request(request, ")
+		assert.NotZero(t, requests, file)
+		assert.Equal(t, requests, strings.Count(string(source), `err = common.ClassifyError(core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo()))`), file)
+	}
+}
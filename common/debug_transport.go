@@ -0,0 +1,116 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// DebugLogTransport is an http.RoundTripper that logs each request and response at debug level,
+// with secrets redacted from headers and bodies. Because it wraps the transport of the
+// client's underlying http.Client, every retry attempt is logged.
+type DebugLogTransport struct {
+	// The transport used to send requests. If nil, http.DefaultTransport is used.
+	Next http.RoundTripper
+
+	// The logger to write to. If nil, the core library's logger is used.
+	Logger core.Logger
+}
+
+// RoundTrip implements http.RoundTripper.
+func (transport *DebugLogTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	next := transport.Next
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	logger := transport.Logger
+	if logger == nil {
+		logger = core.GetLogger()
+	}
+	if !logger.IsLogLevelEnabled(core.LevelDebug) {
+		return next.RoundTrip(req)
+	}
+
+	if req.Body != nil && req.Body != http.NoBody {
+		body, err := io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		req.Body = io.NopCloser(bytes.NewReader(body))
+		logger.Debug("Request: %s %s\n%s\n%s\n", req.Method, req.URL.Redacted(), formatHeaders(req.Header), RedactBody(body))
+	} else {
+		logger.Debug("Request: %s %s\n%s\n", req.Method, req.URL.Redacted(), formatHeaders(req.Header))
+	}
+
+	res, err := next.RoundTrip(req)
+	if err != nil {
+		logger.Debug("Request failed: %s %s: %s\n", req.Method, req.URL.Redacted(), err.Error())
+		return res, err
+	}
+	body, err := io.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	res.Body = io.NopCloser(bytes.NewReader(body))
+	logger.Debug("Response: %s\n%s\n%s\n", res.Status, formatHeaders(res.Header), RedactBody(body))
+	return res, nil
+}
+
+// formatHeaders renders headers one per line, sorted by name, with credentials redacted.
+func formatHeaders(headers http.Header) string {
+	lines := make([]string, 0, len(headers))
+	for name, values := range headers {
+		lines = append(lines, fmt.Sprintf("%s: %s", name, strings.Join(values, ", ")))
+	}
+	sort.Strings(lines)
+	return core.RedactSecrets(strings.Join(lines, "\n"))
+}
+
+func (transport *DebugLogTransport) layer() int {
+	return transportLayerDebugLog
+}
+
+func (transport *DebugLogTransport) next() http.RoundTripper {
+	return transport.Next
+}
+
+func (transport *DebugLogTransport) withNext(next http.RoundTripper) chainedTransport {
+	transportCopy := *transport
+	transportCopy.Next = next
+	return &transportCopy
+}
+
+// EnableDebugLogging installs a DebugLogTransport on the service's HTTP client. Calling it again
+// replaces the logger rather than installing a second transport. The HTTP client is copied
+// rather than modified in place, so clones of the service are not affected.
+func EnableDebugLogging(service *core.BaseService, logger core.Logger) {
+	installTransport(service, transportLayerDebugLog, &DebugLogTransport{Logger: logger})
+}
+
+// DisableDebugLogging removes a DebugLogTransport installed by EnableDebugLogging.
+func DisableDebugLogging(service *core.BaseService) {
+	installTransport(service, transportLayerDebugLog, nil)
+}
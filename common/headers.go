@@ -26,6 +26,7 @@ import (
 const (
 	sdkName = "continuous-delivery-go-sdk"
 	headerNameUserAgent = "User-Agent"
+	headerNameSdkAnalytics = "X-IBMCloud-SDK-Analytics"
 )
 
 //
@@ -67,6 +68,7 @@ func GetSdkHeaders(serviceName string, serviceVersion string, operationId string
 	sdkHeaders := make(map[string]string)
 
 	sdkHeaders[headerNameUserAgent] = GetUserAgentInfo()
+	sdkHeaders[headerNameSdkAnalytics] = fmt.Sprintf("service_name=%s;service_version=%s;operation_id=%s", serviceName, serviceVersion, operationId)
 
 	return sdkHeaders
 }
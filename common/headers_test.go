@@ -40,4 +40,6 @@ func TestGetSdkHeaders(t *testing.T) {
 	_, foundIt = headers[headerNameUserAgent]
 	assert.True(t, foundIt)
 	t.Logf("user agent: %s\n", headers[headerNameUserAgent])
+
+	assert.Equal(t, "service_name=myService;service_version=v123;operation_id=myOperation", headers[headerNameSdkAnalytics])
 }
@@ -0,0 +1,137 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"net/http"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Defaults of IdempotencyOptions.
+const (
+	DefaultIdempotentCreateAttempts      = 3
+	DefaultIdempotentCreateRetryInterval = time.Second
+)
+
+// IdempotencyClockSkew is the allowance for clock differences with the service used when
+// recognizing the resources created since an operation started.
+const IdempotencyClockSkew = time.Minute
+
+// IdempotencyOptions : The options of the idempotent create operations. Zero values select the
+// defaults.
+type IdempotencyOptions struct {
+	// The marker that identifies the resource across attempts, for resources that cannot be
+	// recognized by their name. Defaults to a random key.
+	Key string
+
+	// The maximum number of create attempts.
+	MaxAttempts int
+
+	// The delay before looking for the resource after an ambiguous failure, doubled after each
+	// further attempt.
+	RetryInterval time.Duration
+}
+
+// NewIdempotencyKey returns a random key for IdempotencyOptions.
+func NewIdempotencyKey() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// IsAmbiguousFailure returns true if err leaves unknown whether the service carried out the
+// request: no response was received, or the response has status 408 or a 5xx status other than
+// 501.
+func IsAmbiguousFailure(response *core.DetailedResponse, err error) bool {
+	if err == nil {
+		return false
+	}
+	if response == nil {
+		return true
+	}
+	return response.StatusCode == http.StatusRequestTimeout ||
+		(response.StatusCode >= 500 && response.StatusCode != http.StatusNotImplemented)
+}
+
+// CreateIdempotently calls create until it succeeds, fails unambiguously or runs out of attempts.
+// After each ambiguous failure, it calls find and returns the resource found, if any, instead of
+// creating a duplicate; the response is then a synthetic one with status 200. A response with
+// status 429 is retried after the delay of its Retry-After header, without a lookup. If find
+// fails, the create error is returned as it is no longer safe to retry.
+func CreateIdempotently[T any](ctx context.Context, options *IdempotencyOptions,
+	create func(ctx context.Context) (T, *core.DetailedResponse, error),
+	find func(ctx context.Context) (T, bool, error)) (result T, response *core.DetailedResponse, err error) {
+	maxAttempts := DefaultIdempotentCreateAttempts
+	retryInterval := DefaultIdempotentCreateRetryInterval
+	if options != nil && options.MaxAttempts > 0 {
+		maxAttempts = options.MaxAttempts
+	}
+	if options != nil && options.RetryInterval > 0 {
+		retryInterval = options.RetryInterval
+	}
+
+	for attempt := 1; ; attempt++ {
+		result, response, err = create(ctx)
+		ambiguous := IsAmbiguousFailure(response, err)
+		throttled := err != nil && response != nil && response.StatusCode == http.StatusTooManyRequests
+		if (!ambiguous && !throttled) || ctx.Err() != nil {
+			return
+		}
+		delay := retryInterval
+		if throttled {
+			if retryAfter, ok := RetryAfter(response.Headers, time.Now()); ok {
+				delay = retryAfter
+			}
+		}
+		if sleepErr := sleepContext(ctx, delay); sleepErr != nil {
+			return
+		}
+		if ambiguous {
+			existing, found, findErr := find(ctx)
+			if findErr != nil {
+				core.GetLogger().Debug("Failed to look for the resource of an ambiguous create: %s", findErr.Error())
+				return
+			}
+			if found {
+				result, err = existing, nil
+				response = &core.DetailedResponse{StatusCode: http.StatusOK, Headers: http.Header{}, Result: existing}
+				return
+			}
+		}
+		if attempt >= maxAttempts {
+			return
+		}
+		retryInterval *= 2
+	}
+}
+
+// sleepContext waits for delay, or until ctx is done.
+func sleepContext(ctx context.Context, delay time.Duration) error {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
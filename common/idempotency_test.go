@@ -0,0 +1,100 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsAmbiguousFailure(t *testing.T) {
+	failure := errors.New("failure")
+	assert.False(t, IsAmbiguousFailure(&core.DetailedResponse{StatusCode: 201}, nil))
+	assert.True(t, IsAmbiguousFailure(nil, failure))
+	assert.True(t, IsAmbiguousFailure(&core.DetailedResponse{StatusCode: 504}, failure))
+	assert.True(t, IsAmbiguousFailure(&core.DetailedResponse{StatusCode: 408}, failure))
+	assert.False(t, IsAmbiguousFailure(&core.DetailedResponse{StatusCode: 501}, failure))
+	assert.False(t, IsAmbiguousFailure(&core.DetailedResponse{StatusCode: 409}, failure))
+}
+
+func TestCreateIdempotently(t *testing.T) {
+	options := &IdempotencyOptions{RetryInterval: time.Millisecond}
+	failure := errors.New("failure")
+	var statuses []int
+	var lookups int
+	create := func(ctx context.Context) (string, *core.DetailedResponse, error) {
+		status := statuses[0]
+		statuses = statuses[1:]
+		if status >= 400 {
+			return "", &core.DetailedResponse{StatusCode: status, Headers: http.Header{}}, failure
+		}
+		return "created", &core.DetailedResponse{StatusCode: status}, nil
+	}
+	find := func(found bool, err error) func(ctx context.Context) (string, bool, error) {
+		return func(ctx context.Context) (string, bool, error) {
+			lookups++
+			if found {
+				return "existing", true, nil
+			}
+			return "", false, err
+		}
+	}
+
+	// The resource created by the failed attempt is returned.
+	statuses, lookups = []int{503}, 0
+	result, response, err := CreateIdempotently(context.Background(), options, create, find(true, nil))
+	assert.Nil(t, err)
+	assert.Equal(t, "existing", result)
+	assert.Equal(t, 200, response.StatusCode)
+	assert.Equal(t, 1, lookups)
+
+	// No resource was created, so the request is retried; 429 is retried without a lookup.
+	statuses, lookups = []int{503, 429, 201}, 0
+	result, response, err = CreateIdempotently(context.Background(), options, create, find(false, nil))
+	assert.Nil(t, err)
+	assert.Equal(t, "created", result)
+	assert.Equal(t, 201, response.StatusCode)
+	assert.Equal(t, 1, lookups)
+
+	// Unambiguous failures are returned at once.
+	statuses, lookups = []int{400}, 0
+	_, response, err = CreateIdempotently(context.Background(), options, create, find(true, nil))
+	assert.Equal(t, failure, err)
+	assert.Equal(t, 400, response.StatusCode)
+	assert.Zero(t, lookups)
+
+	// A failed lookup ends the retries.
+	statuses, lookups = []int{503, 201}, 0
+	_, _, err = CreateIdempotently(context.Background(), options, create, find(false, errors.New("lookup")))
+	assert.Equal(t, failure, err)
+	assert.Equal(t, []int{201}, statuses)
+
+	// The attempts are bounded.
+	statuses, lookups = []int{503, 503, 201}, 0
+	_, response, err = CreateIdempotently(context.Background(), &IdempotencyOptions{MaxAttempts: 2, RetryInterval: time.Millisecond}, create, find(false, nil))
+	assert.Equal(t, failure, err)
+	assert.Equal(t, 503, response.StatusCode)
+	assert.Equal(t, 2, lookups)
+
+	assert.Len(t, NewIdempotencyKey(), 32)
+}
@@ -0,0 +1,52 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"context"
+	"iter"
+)
+
+// PageItems returns an iterator over the items of the pages returned by next, which is called
+// lazily while hasNext reports more pages. No further page is fetched once the loop breaks. An
+// error is yielded with the zero item and ends the iteration.
+func PageItems[T any](ctx context.Context, hasNext func() bool, next func(ctx context.Context) ([]T, error)) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for hasNext() {
+			page, err := next(ctx)
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+			for _, item := range page {
+				if !yield(item, nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// ErrorItems returns an iterator that yields err once with the zero item, for iterator
+// constructors that fail before the first page is fetched.
+func ErrorItems[T any](err error) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+		yield(zero, err)
+	}
+}
@@ -0,0 +1,79 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPageItems(t *testing.T) {
+	pages := [][]int{{1, 2}, {3}, {4, 5}}
+	fetched := 0
+	items := PageItems(context.Background(), func() bool { return fetched < len(pages) }, func(ctx context.Context) ([]int, error) {
+		fetched++
+		return pages[fetched-1], nil
+	})
+
+	var all []int
+	for item, err := range items {
+		assert.Nil(t, err)
+		all = append(all, item)
+	}
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, all)
+	assert.Equal(t, 3, fetched)
+
+	fetched = 0
+	for item := range items {
+		if item == 2 {
+			break
+		}
+	}
+	assert.Equal(t, 1, fetched)
+
+	failure := errors.New("failure")
+	fetched = 0
+	items = PageItems(context.Background(), func() bool { return true }, func(ctx context.Context) ([]int, error) {
+		fetched++
+		if fetched == 2 {
+			return nil, failure
+		}
+		return []int{fetched}, nil
+	})
+	var errs []error
+	all = nil
+	for item, err := range items {
+		all = append(all, item)
+		errs = append(errs, err)
+	}
+	assert.Equal(t, []int{1, 0}, all)
+	assert.Equal(t, []error{nil, failure}, errs)
+}
+
+func TestErrorItems(t *testing.T) {
+	failure := errors.New("failure")
+	calls := 0
+	for item, err := range ErrorItems[string](failure) {
+		calls++
+		assert.Equal(t, "", item)
+		assert.Equal(t, failure, err)
+	}
+	assert.Equal(t, 1, calls)
+}
@@ -0,0 +1,62 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// logRequest writes the record of a request sent by TelemetryTransport. res is nil if the request
+// failed without a response.
+func (telemetry *Telemetry) logRequest(ctx context.Context, operationID string, req *http.Request, requestBody []byte,
+	res *http.Response, responseBody []byte, err error, duration time.Duration, resendCount int) {
+	logger := telemetry.options.Logger
+	level, message := telemetry.options.Level.Level(), "Request succeeded"
+	if err != nil {
+		level, message = telemetry.options.ErrorLevel.Level(), "Request failed"
+	}
+	if !logger.Enabled(ctx, level) {
+		return
+	}
+
+	attrs := []slog.Attr{
+		slog.String("operation", operationID),
+		slog.String("method", req.Method),
+		slog.String("path", req.URL.Path),
+	}
+	if res != nil {
+		attrs = append(attrs, slog.Int("status", res.StatusCode))
+	}
+	attrs = append(attrs, slog.Duration("duration", duration), slog.Int("retries", resendCount))
+	if err != nil {
+		attrs = append(attrs, slog.String("error", core.RedactSecrets(err.Error())))
+	}
+	if telemetry.options.LogBodies {
+		if len(requestBody) > 0 {
+			attrs = append(attrs, slog.String("request_body", string(RedactBody(requestBody))))
+		}
+		if len(responseBody) > 0 {
+			attrs = append(attrs, slog.String("response_body", string(RedactBody(responseBody))))
+		}
+	}
+	logger.LogAttrs(ctx, level, message, attrs...)
+}
@@ -0,0 +1,104 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTelemetryLogging(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		res.Header().Set("Content-Type", "application/json")
+		if req.Method == http.MethodGet {
+			res.WriteHeader(404)
+			_, _ = res.Write([]byte(`{"errors": [{"message": "not found"}], "api_key": "s1"}`))
+			return
+		}
+		res.WriteHeader(201)
+		_, _ = res.Write([]byte(`{"name": "p", "type": "secure", "value": "s2"}`))
+	}))
+	defer server.Close()
+	service, err := core.NewBaseService(&core.ServiceOptions{URL: server.URL, Authenticator: &core.NoAuthAuthenticator{}})
+	assert.Nil(t, err)
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelInfo}))
+	send := func(telemetry *Telemetry, method string) []map[string]interface{} {
+		buf.Reset()
+		builder := core.NewRequestBuilder(method)
+		_, err := builder.ResolveRequestURL(server.URL, "/tekton_pipelines/p1/properties", nil)
+		assert.Nil(t, err)
+		if method == core.POST {
+			_, err = builder.SetBodyContentJSON(map[string]interface{}{"name": "p", "type": "secure", "value": "s3"})
+			assert.Nil(t, err)
+		}
+		req, err := builder.Build()
+		assert.Nil(t, err)
+		var result map[string]json.RawMessage
+		EnableTelemetry(service, telemetry)
+		_, _ = sendOperation(service, "Operation", req, &result)
+		var records []map[string]interface{}
+		for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+			if line != "" {
+				var record map[string]interface{}
+				assert.Nil(t, json.Unmarshal([]byte(line), &record))
+				records = append(records, record)
+			}
+		}
+		return records
+	}
+
+	// Successful requests are logged at debug level by default.
+	telemetry := NewTelemetry(&TelemetryOptions{Logger: logger, LogBodies: true})
+	assert.Empty(t, send(telemetry, core.POST))
+	records := send(telemetry, core.GET)
+	assert.Len(t, records, 1)
+	record := records[0]
+	assert.Equal(t, "WARN", record["level"])
+	assert.Equal(t, "Request failed", record["msg"])
+	assert.Equal(t, "Operation", record["operation"])
+	assert.Equal(t, "GET", record["method"])
+	assert.Equal(t, "/tekton_pipelines/p1/properties", record["path"])
+	assert.Equal(t, 404.0, record["status"])
+	assert.Equal(t, 0.0, record["retries"])
+	assert.Equal(t, "not found", record["error"])
+	assert.NotContains(t, record["response_body"], "s1")
+	assert.Contains(t, record, "duration")
+
+	telemetry = NewTelemetry(&TelemetryOptions{Logger: logger, Level: slog.LevelInfo, LogBodies: true})
+	records = send(telemetry, core.POST)
+	assert.Len(t, records, 1)
+	assert.Equal(t, "Request succeeded", records[0]["msg"])
+	assert.Contains(t, records[0]["request_body"], RedactedValue)
+	assert.Contains(t, records[0]["response_body"], RedactedValue)
+	assert.NotContains(t, buf.String(), "s2")
+	assert.NotContains(t, buf.String(), "s3")
+
+	telemetry = NewTelemetry(&TelemetryOptions{Logger: logger, Level: slog.LevelInfo})
+	records = send(telemetry, core.POST)
+	assert.NotContains(t, records[0], "request_body")
+	assert.NotContains(t, records[0], "response_body")
+}
@@ -0,0 +1,174 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package otel provides the telemetry options of the service clients for OpenTelemetry: a tracer,
+// the request metrics and the propagation of the trace context with the global TextMapPropagator.
+// It is a separate module, so that the clients do not depend on OpenTelemetry.
+package otel
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	common "github.com/IBM/continuous-delivery-go-sdk/v2/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+	otelapi "go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// InstrumentationName is the name of the tracer and meter of the service clients.
+const InstrumentationName = "github.com/IBM/continuous-delivery-go-sdk/v2"
+
+// The names of the metrics recorded by the service clients.
+const (
+	RequestDurationMetric = "cd.client.request.duration"
+	RequestErrorsMetric   = "cd.client.request.errors"
+)
+
+// NewTelemetryOptions returns the options of a common.Telemetry that traces the operations with
+// tracerProvider, records their metrics with meterProvider, and propagates their spans with the
+// global TextMapPropagator. Nil providers default to the global providers.
+func NewTelemetryOptions(tracerProvider trace.TracerProvider, meterProvider metric.MeterProvider) (options *common.TelemetryOptions, err error) {
+	if tracerProvider == nil {
+		tracerProvider = otelapi.GetTracerProvider()
+	}
+	if meterProvider == nil {
+		meterProvider = otelapi.GetMeterProvider()
+	}
+	meter := meterProvider.Meter(InstrumentationName, metric.WithInstrumentationVersion(common.Version))
+	requestDuration, err := meter.Float64Histogram(RequestDurationMetric,
+		metric.WithUnit("s"), metric.WithDescription("The duration of the operations, retries included."))
+	if err != nil {
+		err = core.SDKErrorf(err, "", "otel-instrument-error", common.GetComponentInfo())
+		return
+	}
+	requestErrors, err := meter.Int64Counter(RequestErrorsMetric,
+		metric.WithUnit("{operation}"), metric.WithDescription("The number of operations that failed."))
+	if err != nil {
+		err = core.SDKErrorf(err, "", "otel-instrument-error", common.GetComponentInfo())
+		return
+	}
+	options = &common.TelemetryOptions{
+		Tracer:          NewTracer(tracerProvider.Tracer(InstrumentationName, trace.WithInstrumentationVersion(common.Version))),
+		RequestDuration: NewFloat64Histogram(requestDuration),
+		RequestErrors:   NewInt64Counter(requestErrors),
+		Propagate:       Propagate,
+	}
+	return
+}
+
+// Propagate injects the trace context of ctx into header with the global TextMapPropagator. It is
+// meant for common.TelemetryOptions.Propagate.
+func Propagate(ctx context.Context, header http.Header) {
+	otelapi.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(header))
+}
+
+// NewTracer adapts an OpenTelemetry tracer. The spans are client spans.
+func NewTracer(tracer trace.Tracer) common.Tracer {
+	return &tracerAdapter{tracer: tracer}
+}
+
+type tracerAdapter struct {
+	tracer trace.Tracer
+}
+
+func (adapter *tracerAdapter) Start(ctx context.Context, spanName string) (context.Context, common.Span) {
+	ctx, span := adapter.tracer.Start(ctx, spanName, trace.WithSpanKind(trace.SpanKindClient))
+	return ctx, &spanAdapter{span: span}
+}
+
+type spanAdapter struct {
+	span trace.Span
+}
+
+func (adapter *spanAdapter) SetAttributes(attributes ...common.Attribute) {
+	adapter.span.SetAttributes(keyValues(attributes)...)
+}
+
+func (adapter *spanAdapter) RecordError(err error) {
+	adapter.span.RecordError(err)
+	adapter.span.SetStatus(codes.Error, err.Error())
+}
+
+func (adapter *spanAdapter) End() {
+	adapter.span.End()
+}
+
+func (adapter *spanAdapter) TraceContext() common.TraceContext {
+	spanContext := adapter.span.SpanContext()
+	return common.TraceContext{
+		TraceID:    spanContext.TraceID(),
+		SpanID:     spanContext.SpanID(),
+		Sampled:    spanContext.IsSampled(),
+		TraceState: spanContext.TraceState().String(),
+	}
+}
+
+// NewFloat64Histogram adapts an OpenTelemetry histogram.
+func NewFloat64Histogram(histogram metric.Float64Histogram) common.Float64Histogram {
+	return &histogramAdapter{histogram: histogram}
+}
+
+type histogramAdapter struct {
+	histogram metric.Float64Histogram
+}
+
+func (adapter *histogramAdapter) Record(ctx context.Context, value float64, attributes ...common.Attribute) {
+	adapter.histogram.Record(ctx, value, metric.WithAttributes(keyValues(attributes)...))
+}
+
+// NewInt64Counter adapts an OpenTelemetry counter.
+func NewInt64Counter(counter metric.Int64Counter) common.Int64Counter {
+	return &counterAdapter{counter: counter}
+}
+
+type counterAdapter struct {
+	counter metric.Int64Counter
+}
+
+func (adapter *counterAdapter) Add(ctx context.Context, incr int64, attributes ...common.Attribute) {
+	adapter.counter.Add(ctx, incr, metric.WithAttributes(keyValues(attributes)...))
+}
+
+// keyValues converts attributes to OpenTelemetry attributes. Values of other types than strings,
+// booleans and numbers are formatted as strings.
+func keyValues(attributes []common.Attribute) []attribute.KeyValue {
+	keyValues := make([]attribute.KeyValue, len(attributes))
+	for i, a := range attributes {
+		switch value := a.Value.(type) {
+		case string:
+			keyValues[i] = attribute.String(a.Key, value)
+		case bool:
+			keyValues[i] = attribute.Bool(a.Key, value)
+		case int:
+			keyValues[i] = attribute.Int(a.Key, value)
+		case int64:
+			keyValues[i] = attribute.Int64(a.Key, value)
+		case float64:
+			keyValues[i] = attribute.Float64(a.Key, value)
+		case []string:
+			keyValues[i] = attribute.StringSlice(a.Key, value)
+		default:
+			keyValues[i] = attribute.String(a.Key, fmt.Sprint(value))
+		}
+	}
+	return keyValues
+}
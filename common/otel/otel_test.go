@@ -0,0 +1,104 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package otel
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	common "github.com/IBM/continuous-delivery-go-sdk/v2/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/stretchr/testify/assert"
+	otelapi "go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric/noop"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestTelemetryOptions(t *testing.T) {
+	var traceParents []string
+	server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		traceParents = append(traceParents, req.Header.Get("traceparent"))
+		if req.URL.Path == "/missing" {
+			res.WriteHeader(404)
+		}
+	}))
+	defer server.Close()
+
+	recorder := tracetest.NewSpanRecorder()
+	defer otelapi.SetTextMapPropagator(otelapi.GetTextMapPropagator())
+	otelapi.SetTextMapPropagator(propagation.TraceContext{})
+	options, err := NewTelemetryOptions(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)), noop.NewMeterProvider())
+	assert.Nil(t, err)
+	telemetry := common.NewTelemetry(options)
+	service, err := core.NewBaseService(&core.ServiceOptions{URL: server.URL, Authenticator: &core.NoAuthAuthenticator{}})
+	assert.Nil(t, err)
+
+	common.EnableTelemetry(service, telemetry)
+	request := func(path string) error {
+		req, _ := http.NewRequest("GET", server.URL+path, nil)
+		for name, value := range common.GetSdkHeaders("cd_toolchain", "V2", "GetToolchainByID") {
+			req.Header.Set(name, value)
+		}
+		_, err := service.Request(req, nil)
+		return err
+	}
+	assert.Nil(t, request("/toolchains/tc1"))
+	assert.NotNil(t, request("/missing"))
+
+	spans := recorder.Ended()
+	assert.Len(t, spans, 2)
+	assert.Equal(t, "GetToolchainByID", spans[0].Name())
+	assert.Equal(t, trace.SpanKindClient, spans[0].SpanKind())
+	assert.Contains(t, spans[0].Attributes(), attribute.String(common.AttributeToolchainID, "tc1"))
+	assert.Contains(t, spans[0].Attributes(), attribute.Int(common.AttributeHTTPStatusCode, 200))
+	assert.Equal(t, codes.Error, spans[1].Status().Code)
+
+	// The spans are propagated by the global propagator.
+	assert.Equal(t, []string{
+		"00-" + spans[0].SpanContext().TraceID().String() + "-" + spans[0].SpanContext().SpanID().String() + "-01",
+		"00-" + spans[1].SpanContext().TraceID().String() + "-" + spans[1].SpanContext().SpanID().String() + "-01",
+	}, traceParents)
+}
+
+func TestKeyValues(t *testing.T) {
+	assert.Equal(t, []attribute.KeyValue{
+		attribute.String("s", "v"),
+		attribute.Bool("b", true),
+		attribute.Int("i", 1),
+		attribute.Float64("f", 0.5),
+		attribute.StringSlice("l", []string{"a"}),
+		attribute.String("e", "failure"),
+	}, keyValues([]common.Attribute{
+		{Key: "s", Value: "v"}, {Key: "b", Value: true}, {Key: "i", Value: 1}, {Key: "f", Value: 0.5},
+		{Key: "l", Value: []string{"a"}}, {Key: "e", Value: errors.New("failure")},
+	}))
+	assert.Empty(t, keyValues(nil))
+}
+
+func TestPropagateWithoutSpan(t *testing.T) {
+	header := http.Header{}
+	Propagate(context.Background(), header)
+	assert.Empty(t, header.Get("traceparent"))
+}
@@ -0,0 +1,46 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"context"
+	"time"
+)
+
+// PollUntil calls condition immediately and then every interval until it reports done, returns an
+// error, or ctx is done, in which case the context's error is returned.
+func PollUntil(ctx context.Context, interval time.Duration, condition func(ctx context.Context) (done bool, err error)) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		done, err := condition(ctx)
+		if err != nil || done {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Defaults of the waiters of the service clients.
+const (
+	DefaultWaitInterval = 5 * time.Second
+	DefaultWaitTimeout  = 10 * time.Minute
+)
@@ -0,0 +1,49 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPollUntil(t *testing.T) {
+	calls := 0
+	err := PollUntil(context.Background(), time.Millisecond, func(ctx context.Context) (bool, error) {
+		calls++
+		return calls == 3, nil
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 3, calls)
+
+	failure := errors.New("failure")
+	err = PollUntil(context.Background(), time.Millisecond, func(ctx context.Context) (bool, error) {
+		return false, failure
+	})
+	assert.Equal(t, failure, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	err = PollUntil(ctx, time.Millisecond, func(ctx context.Context) (bool, error) {
+		return false, nil
+	})
+	assert.True(t, errors.Is(err, context.DeadlineExceeded))
+}
@@ -0,0 +1,147 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"context"
+	"iter"
+	"sync"
+)
+
+// DefaultPrefetchDepth is the number of pages fetched ahead of the consumer when
+// PrefetchOptions.Depth is not set.
+const DefaultPrefetchDepth = 1
+
+// PrefetchOptions : How far a prefetching iterator reads ahead of its consumer.
+type PrefetchOptions struct {
+	// The maximum number of pages fetched ahead of the page being consumed. Defaults to
+	// DefaultPrefetchDepth.
+	Depth int
+
+	// If set, no further page is fetched while the pages fetched ahead hold at least this many
+	// items. One page is always allowed ahead, so a page larger than the bound is still fetched.
+	MaxBufferedItems int
+}
+
+type prefetchedPage[T any] struct {
+	items []T
+	err   error
+}
+
+// PrefetchPages returns an iterator over the pages returned by next, like PageItems, except that
+// the pages are fetched by a background goroutine that runs ahead of the loop within the bounds of
+// options. hasNext and next are only called from that goroutine, which is stopped, and waited for,
+// when the loop ends, breaks, or ctx is done.
+func PrefetchPages[T any](ctx context.Context, hasNext func() bool, next func(ctx context.Context) ([]T, error), options *PrefetchOptions) iter.Seq2[[]T, error] {
+	depth, maxItems := DefaultPrefetchDepth, 0
+	if options != nil {
+		if options.Depth > 0 {
+			depth = options.Depth
+		}
+		maxItems = options.MaxBufferedItems
+	}
+	return func(yield func([]T, error) bool) {
+		ctx, cancel := context.WithCancel(ctx)
+		var producer sync.WaitGroup
+		defer producer.Wait()
+		defer cancel()
+
+		// The producer holds one page while it waits to send it, hence the depth-1 capacity.
+		pages := make(chan prefetchedPage[T], depth-1)
+		var mutex sync.Mutex
+		buffered := 0
+		space := make(chan struct{}, 1)
+
+		producer.Add(1)
+		go func() {
+			defer producer.Done()
+			defer close(pages)
+			for hasNext() {
+				for maxItems > 0 {
+					mutex.Lock()
+					full := buffered >= maxItems
+					mutex.Unlock()
+					if !full {
+						break
+					}
+					select {
+					case <-space:
+					case <-ctx.Done():
+						return
+					}
+				}
+				items, err := next(ctx)
+				if ctx.Err() != nil {
+					return
+				}
+				mutex.Lock()
+				buffered += len(items)
+				mutex.Unlock()
+				select {
+				case pages <- prefetchedPage[T]{items, err}:
+				case <-ctx.Done():
+					return
+				}
+				if err != nil {
+					return
+				}
+			}
+		}()
+
+		for {
+			select {
+			case page, ok := <-pages:
+				if !ok {
+					return
+				}
+				mutex.Lock()
+				buffered -= len(page.items)
+				mutex.Unlock()
+				select {
+				case space <- struct{}{}:
+				default:
+				}
+				if !yield(page.items, page.err) || page.err != nil {
+					return
+				}
+			case <-ctx.Done():
+				yield(nil, ctx.Err())
+				return
+			}
+		}
+	}
+}
+
+// PrefetchItems returns an iterator over the items of the pages returned by next, fetched ahead
+// of the loop as described by PrefetchPages. An error is yielded with the zero item and ends the
+// iteration.
+func PrefetchItems[T any](ctx context.Context, hasNext func() bool, next func(ctx context.Context) ([]T, error), options *PrefetchOptions) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for page, err := range PrefetchPages(ctx, hasNext, next, options) {
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+			for _, item := range page {
+				if !yield(item, nil) {
+					return
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,109 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// pageSource serves count pages of size items, counting the pages fetched.
+type pageSource struct {
+	count, size int
+	fetched     atomic.Int32
+	failAt      int
+}
+
+func (source *pageSource) hasNext() bool {
+	return int(source.fetched.Load()) < source.count
+}
+
+func (source *pageSource) next(ctx context.Context) ([]int, error) {
+	n := int(source.fetched.Add(1))
+	if n == source.failAt {
+		return nil, errors.New("failure")
+	}
+	page := make([]int, source.size)
+	for i := range page {
+		page[i] = (n-1)*source.size + i
+	}
+	return page, nil
+}
+
+func TestPrefetchItems(t *testing.T) {
+	source := &pageSource{count: 5, size: 3}
+	var all []int
+	for item, err := range PrefetchItems(context.Background(), source.hasNext, source.next, &PrefetchOptions{Depth: 2}) {
+		assert.Nil(t, err)
+		all = append(all, item)
+	}
+	assert.Len(t, all, 15)
+	assert.Equal(t, 14, all[14])
+
+	source = &pageSource{count: 5, size: 3, failAt: 2}
+	var errs []error
+	for _, err := range PrefetchItems(context.Background(), source.hasNext, source.next, nil) {
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+	assert.Len(t, errs, 1)
+}
+
+func TestPrefetchLookahead(t *testing.T) {
+	for _, test := range []struct {
+		options *PrefetchOptions
+		fetched int32
+	}{
+		{nil, 2},
+		{&PrefetchOptions{Depth: 3}, 4},
+		{&PrefetchOptions{Depth: 3, MaxBufferedItems: 4}, 3},
+		{&PrefetchOptions{Depth: 3, MaxBufferedItems: 1}, 2},
+	} {
+		source := &pageSource{count: 10, size: 2}
+		for range PrefetchPages(context.Background(), source.hasNext, source.next, test.options) {
+			// While the first page is consumed, the producer fetches ahead until it is blocked.
+			assert.Eventually(t, func() bool { return source.fetched.Load() == test.fetched }, time.Second, time.Millisecond)
+			time.Sleep(20 * time.Millisecond)
+			assert.Equal(t, test.fetched, source.fetched.Load())
+			break
+		}
+		// Breaking the loop stops the producer before the iterator returns.
+		fetched := source.fetched.Load()
+		time.Sleep(20 * time.Millisecond)
+		assert.Equal(t, fetched, source.fetched.Load())
+	}
+}
+
+func TestPrefetchCancellation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	blocked := func(ctx context.Context) ([]int, error) {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}
+	time.AfterFunc(10*time.Millisecond, cancel)
+	var errs []error
+	for _, err := range PrefetchItems(ctx, func() bool { return true }, blocked, nil) {
+		errs = append(errs, err)
+	}
+	assert.Equal(t, []error{context.Canceled}, errs)
+}
@@ -0,0 +1,250 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// RateLimiterOptions : The NewRateLimiter options. Zero values disable the corresponding limit.
+type RateLimiterOptions struct {
+	// The maximum number of requests per second.
+	Rate float64
+
+	// The number of requests that can be sent at once after a quiet period. Defaults to 1.
+	Burst int
+
+	// The maximum number of requests awaiting their response at any time.
+	MaxInFlight int
+
+	// The lowest rate that responses with status 429 can reduce Rate to. Defaults to Rate/10.
+	MinRate float64
+}
+
+// RateLimiter : A token bucket and a concurrency limit shared by every request sent through it.
+// It adapts to the service: each response with status 429 halves the current rate, down to
+// MinRate, and holds back every request until the delay of its Retry-After header has passed;
+// each other response raises the rate by a twentieth of Rate, up to Rate.
+//
+// A RateLimiter is installed on a client with the RateLimiter field of its options. It sits below
+// the retry logic enabled by EnableRetries, so that each retry attempt is limited and counted.
+// Clones of the client, and any other client given the same RateLimiter, share its limits.
+type RateLimiter struct {
+	options RateLimiterOptions
+	slots   chan struct{}
+
+	// mutex guards the fields below.
+	mutex  sync.Mutex
+	rate   float64
+	tokens float64
+	last   time.Time
+	paused time.Time
+}
+
+// NewRateLimiter returns a RateLimiter with the given options.
+func NewRateLimiter(options *RateLimiterOptions) *RateLimiter {
+	limiter := &RateLimiter{}
+	if options != nil {
+		limiter.options = *options
+	}
+	if limiter.options.Rate < 0 {
+		limiter.options.Rate = 0
+	}
+	if limiter.options.Burst <= 0 {
+		limiter.options.Burst = 1
+	}
+	if limiter.options.MinRate <= 0 || limiter.options.MinRate > limiter.options.Rate {
+		limiter.options.MinRate = limiter.options.Rate / 10
+	}
+	if limiter.options.MaxInFlight > 0 {
+		limiter.slots = make(chan struct{}, limiter.options.MaxInFlight)
+	}
+	limiter.rate = limiter.options.Rate
+	limiter.tokens = float64(limiter.options.Burst)
+	return limiter
+}
+
+// Rate returns the current number of requests allowed per second, or zero if the rate is not
+// limited.
+func (limiter *RateLimiter) Rate() float64 {
+	limiter.mutex.Lock()
+	defer limiter.mutex.Unlock()
+	return limiter.rate
+}
+
+// InFlight returns the number of requests awaiting their response.
+func (limiter *RateLimiter) InFlight() int {
+	return len(limiter.slots)
+}
+
+// Acquire waits until a request can be sent, or until ctx is done. The returned function must be
+// called once the response body has been read or closed.
+func (limiter *RateLimiter) Acquire(ctx context.Context) (release func(), err error) {
+	release = func() {}
+	if limiter.slots != nil {
+		select {
+		case limiter.slots <- struct{}{}:
+			release = func() { <-limiter.slots }
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	limiter.mutex.Lock()
+	delay := limiter.reserve(time.Now())
+	limiter.mutex.Unlock()
+	if delay <= 0 {
+		return
+	}
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return
+	case <-ctx.Done():
+		release()
+		return nil, ctx.Err()
+	}
+}
+
+// reserve takes a token and returns the time to wait before using it.
+func (limiter *RateLimiter) reserve(now time.Time) time.Duration {
+	start := now
+	if limiter.paused.After(start) {
+		start = limiter.paused
+	}
+	if limiter.rate <= 0 {
+		return start.Sub(now)
+	}
+	if start.After(limiter.last) {
+		limiter.tokens = min(float64(limiter.options.Burst), limiter.tokens+start.Sub(limiter.last).Seconds()*limiter.rate)
+		limiter.last = start
+	}
+	limiter.tokens--
+	delay := limiter.last.Sub(now)
+	if limiter.tokens < 0 {
+		delay += time.Duration(-limiter.tokens / limiter.rate * float64(time.Second))
+	}
+	return delay
+}
+
+// Observe adapts the limits to a response received from the service.
+func (limiter *RateLimiter) Observe(res *http.Response) {
+	limiter.mutex.Lock()
+	defer limiter.mutex.Unlock()
+	if res.StatusCode != http.StatusTooManyRequests {
+		limiter.rate = min(limiter.options.Rate, limiter.rate+limiter.options.Rate/20)
+		return
+	}
+	limiter.rate = max(limiter.options.MinRate, limiter.rate/2)
+	now := time.Now()
+	if delay, ok := RetryAfter(res.Header, now); ok && now.Add(delay).After(limiter.paused) {
+		limiter.paused = now.Add(delay)
+	}
+	// Requests sent during the pause would be rejected too, so no tokens accrue until it ends.
+	limiter.tokens = min(limiter.tokens, 0)
+	if limiter.last.Before(limiter.paused) {
+		limiter.last = limiter.paused
+	}
+}
+
+// RateLimitTransport is an http.RoundTripper that sends requests within the limits of a
+// RateLimiter and reports the responses to it.
+type RateLimitTransport struct {
+	// The transport used to send requests. If nil, http.DefaultTransport is used.
+	Next http.RoundTripper
+
+	// The limiter applied to requests. If nil, requests are not limited.
+	Limiter *RateLimiter
+}
+
+// RoundTrip implements http.RoundTripper.
+func (transport *RateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	next := transport.Next
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	if transport.Limiter == nil {
+		return next.RoundTrip(req)
+	}
+	release, err := transport.Limiter.Acquire(req.Context())
+	if err != nil {
+		return nil, err
+	}
+	res, err := next.RoundTrip(req)
+	if err != nil {
+		release()
+		return res, err
+	}
+	transport.Limiter.Observe(res)
+	// The request stays in flight until its response body is consumed.
+	res.Body = &releasingBody{ReadCloser: res.Body, release: release}
+	return res, nil
+}
+
+func (transport *RateLimitTransport) layer() int {
+	return transportLayerRateLimit
+}
+
+func (transport *RateLimitTransport) next() http.RoundTripper {
+	return transport.Next
+}
+
+func (transport *RateLimitTransport) withNext(next http.RoundTripper) chainedTransport {
+	transportCopy := *transport
+	transportCopy.Next = next
+	return &transportCopy
+}
+
+// releasingBody is a response body that calls release once it has been read to the end or closed.
+type releasingBody struct {
+	io.ReadCloser
+	once    sync.Once
+	release func()
+}
+
+func (body *releasingBody) Read(p []byte) (n int, err error) {
+	n, err = body.ReadCloser.Read(p)
+	if err == io.EOF {
+		body.once.Do(body.release)
+	}
+	return
+}
+
+func (body *releasingBody) Close() error {
+	err := body.ReadCloser.Close()
+	body.once.Do(body.release)
+	return err
+}
+
+// EnableRateLimiting installs a RateLimitTransport for limiter on the service's HTTP client,
+// replacing one installed before. The HTTP client is copied rather than modified in place, so
+// clones of the service are not affected.
+func EnableRateLimiting(service *core.BaseService, limiter *RateLimiter) {
+	installTransport(service, transportLayerRateLimit, &RateLimitTransport{Limiter: limiter})
+}
+
+// DisableRateLimiting removes a RateLimitTransport installed by EnableRateLimiting.
+func DisableRateLimiting(service *core.BaseService) {
+	installTransport(service, transportLayerRateLimit, nil)
+}
@@ -0,0 +1,144 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRateLimiterReserve(t *testing.T) {
+	now := time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC)
+	limiter := NewRateLimiter(&RateLimiterOptions{Rate: 10, Burst: 2})
+	limiter.last = now
+
+	assert.Zero(t, limiter.reserve(now))
+	assert.Zero(t, limiter.reserve(now))
+	assert.Equal(t, 100*time.Millisecond, limiter.reserve(now))
+	assert.Equal(t, 200*time.Millisecond, limiter.reserve(now))
+	// The debt is paid back as time passes.
+	assert.Equal(t, 200*time.Millisecond, limiter.reserve(now.Add(100*time.Millisecond)))
+
+	unlimited := NewRateLimiter(nil)
+	assert.Zero(t, unlimited.reserve(now))
+	assert.Zero(t, unlimited.Rate())
+}
+
+func TestRateLimiterObserve(t *testing.T) {
+	limiter := NewRateLimiter(&RateLimiterOptions{Rate: 8, MinRate: 3})
+	throttled := &http.Response{StatusCode: 429, Header: http.Header{}}
+	limiter.Observe(throttled)
+	assert.Equal(t, 4.0, limiter.Rate())
+	limiter.Observe(throttled)
+	assert.Equal(t, 3.0, limiter.Rate())
+	limiter.Observe(&http.Response{StatusCode: 200})
+	assert.Equal(t, 3.4, limiter.Rate())
+	for i := 0; i < 20; i++ {
+		limiter.Observe(&http.Response{StatusCode: 200})
+	}
+	assert.Equal(t, 8.0, limiter.Rate())
+
+	// Retry-After holds back every request, even without a rate.
+	unlimited := NewRateLimiter(nil)
+	unlimited.Observe(&http.Response{StatusCode: 429, Header: http.Header{"Retry-After": []string{"1"}}})
+	delay := unlimited.reserve(time.Now())
+	assert.True(t, delay > 900*time.Millisecond && delay <= time.Second, delay)
+}
+
+func TestRateLimiterAcquire(t *testing.T) {
+	limiter := NewRateLimiter(&RateLimiterOptions{MaxInFlight: 1})
+	release, err := limiter.Acquire(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, 1, limiter.InFlight())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	_, err = limiter.Acquire(ctx)
+	assert.Equal(t, context.DeadlineExceeded, err)
+
+	release()
+	assert.Equal(t, 0, limiter.InFlight())
+	release, err = limiter.Acquire(context.Background())
+	assert.Nil(t, err)
+	release()
+}
+
+func TestRateLimitTransport(t *testing.T) {
+	var inFlight, maxInFlight, calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		n := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			m := atomic.LoadInt32(&maxInFlight)
+			if n <= m || atomic.CompareAndSwapInt32(&maxInFlight, m, n) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+		if atomic.AddInt32(&calls, 1) == 1 {
+			res.WriteHeader(429)
+		}
+	}))
+	defer server.Close()
+
+	service, err := core.NewBaseService(&core.ServiceOptions{URL: server.URL, Authenticator: &core.NoAuthAuthenticator{}})
+	assert.Nil(t, err)
+	limiter := NewRateLimiter(&RateLimiterOptions{Rate: 1000, Burst: 10, MaxInFlight: 2})
+	EnableRateLimiting(service, limiter)
+	EnableRateLimiting(service, limiter)
+	_, ok := service.GetHTTPClient().Transport.(*RateLimitTransport).Next.(*RateLimitTransport)
+	assert.False(t, ok)
+
+	res, err := service.GetHTTPClient().Get(server.URL)
+	assert.Nil(t, err)
+	assert.Equal(t, 500.0, limiter.Rate())
+	// The request is in flight until its response body is consumed.
+	assert.Equal(t, 1, limiter.InFlight())
+	_, _ = io.Copy(io.Discard, res.Body)
+	assert.Equal(t, 0, limiter.InFlight())
+	res.Body.Close()
+	assert.Equal(t, 0, limiter.InFlight())
+
+	var wg sync.WaitGroup
+	for i := 0; i < 6; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			res, err := service.Clone().GetHTTPClient().Get(server.URL)
+			assert.Nil(t, err)
+			assert.Equal(t, 200, res.StatusCode)
+			res.Body.Close()
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, int32(2), maxInFlight)
+	assert.Equal(t, 0, limiter.InFlight())
+	assert.Equal(t, 800.0, limiter.Rate())
+
+	DisableRateLimiting(service)
+	_, ok = service.GetHTTPClient().Transport.(*RateLimitTransport)
+	assert.False(t, ok)
+}
@@ -0,0 +1,104 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// RedactedValue replaces secret values in redacted output.
+const RedactedValue = "********"
+
+// sensitiveKeyFragments identify fields that hold credentials.
+var sensitiveKeyFragments = []string{"api_key", "apikey", "password", "secret", "token", "private_key", "webhook"}
+
+// IsSensitiveKey returns true if a field with this name is expected to hold a credential.
+// Fields holding URLs, such as "token_url", are not considered sensitive.
+func IsSensitiveKey(key string) bool {
+	key = strings.ToLower(key)
+	if strings.HasSuffix(key, "_url") {
+		return false
+	}
+	for _, fragment := range sensitiveKeyFragments {
+		if strings.Contains(key, fragment) {
+			return true
+		}
+	}
+	return false
+}
+
+// RedactValue returns a copy of value, as decoded from JSON, in which secrets are replaced by
+// RedactedValue. key is the name of the field holding value, if any. The following are redacted:
+//   - the values of fields for which IsSensitiveKey returns true
+//   - the "value" field of objects whose "type" is "secure", or that are held by a sensitive field
+//   - every value of a "secure_trigger_properties" object
+func RedactValue(key string, value interface{}) interface{} {
+	switch v := value.(type) {
+	case nil:
+		return nil
+	case string:
+		if IsSensitiveKey(key) && v != "" {
+			return RedactedValue
+		}
+	case bool, float64:
+		if IsSensitiveKey(key) {
+			return RedactedValue
+		}
+	case map[string]interface{}:
+		redacted := make(map[string]interface{}, len(v))
+		for k, item := range v {
+			redacted[k] = RedactValue(k, item)
+		}
+		if t, _ := v["type"].(string); t == "secure" || IsSensitiveKey(key) {
+			if s, _ := v["value"].(string); s != "" {
+				redacted["value"] = RedactedValue
+			}
+		}
+		if strings.EqualFold(key, "secure_trigger_properties") {
+			for k := range redacted {
+				redacted[k] = RedactedValue
+			}
+		}
+		return redacted
+	case []interface{}:
+		redacted := make([]interface{}, len(v))
+		for i, item := range v {
+			redacted[i] = RedactValue(key, item)
+		}
+		return redacted
+	}
+	return value
+}
+
+// RedactBody returns a copy of a request or response body with secrets redacted. JSON bodies
+// are redacted with RedactValue; other bodies are redacted with core.RedactSecrets.
+func RedactBody(body []byte) []byte {
+	trimmed := bytes.TrimSpace(body)
+	if len(trimmed) > 0 && (trimmed[0] == '{' || trimmed[0] == '[') {
+		var decoded interface{}
+		if err := json.Unmarshal(trimmed, &decoded); err == nil {
+			if redacted, err := json.Marshal(RedactValue("", decoded)); err == nil {
+				return redacted
+			}
+		}
+	}
+	return []byte(core.RedactSecrets(string(body)))
+}
@@ -0,0 +1,106 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"bytes"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsSensitiveKey(t *testing.T) {
+	assert.True(t, IsSensitiveKey("api_key"))
+	assert.True(t, IsSensitiveKey("GitHub_Token"))
+	assert.True(t, IsSensitiveKey("webhook"))
+	assert.False(t, IsSensitiveKey("webhook_url"))
+	assert.False(t, IsSensitiveKey("name"))
+}
+
+func TestRedactValue(t *testing.T) {
+	assert.Nil(t, RedactValue("token", nil))
+	assert.Equal(t, RedactedValue, RedactValue("token", "abc"))
+	assert.Equal(t, "abc", RedactValue("name", "abc"))
+
+	value := RedactValue("", map[string]interface{}{
+		"properties": []interface{}{
+			map[string]interface{}{"name": "a", "type": "secure", "value": "s1"},
+			map[string]interface{}{"name": "b", "type": "text", "value": "plain"},
+		},
+		"secure_trigger_properties": map[string]interface{}{"anything": "s2"},
+		"parameters":                map[string]interface{}{"api_key": "s3", "region": "us-south"},
+	}).(map[string]interface{})
+	properties := value["properties"].([]interface{})
+	assert.Equal(t, RedactedValue, properties[0].(map[string]interface{})["value"])
+	assert.Equal(t, "plain", properties[1].(map[string]interface{})["value"])
+	assert.Equal(t, RedactedValue, value["secure_trigger_properties"].(map[string]interface{})["anything"])
+	assert.Equal(t, RedactedValue, value["parameters"].(map[string]interface{})["api_key"])
+	assert.Equal(t, "us-south", value["parameters"].(map[string]interface{})["region"])
+}
+
+func TestRedactBody(t *testing.T) {
+	body := string(RedactBody([]byte(`{"name": "p", "type": "secure", "value": "s1"}`)))
+	assert.False(t, strings.Contains(body, "s1"))
+	assert.True(t, strings.Contains(body, RedactedValue))
+
+	body = string(RedactBody([]byte(`apikey=s1`)))
+	assert.False(t, strings.Contains(body, "s1"))
+}
+
+func TestDebugLogTransport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		body, _ := io.ReadAll(req.Body)
+		assert.Equal(t, `{"value":"s1","type":"secure"}`, string(body))
+		res.Header().Set("Content-Type", "application/json")
+		_, _ = res.Write([]byte(`{"parameters": {"api_key": "s2"}}`))
+	}))
+	defer server.Close()
+
+	var buf bytes.Buffer
+	logger := core.NewLogger(core.LevelDebug, log.New(&buf, "", 0), log.New(&buf, "", 0))
+	service, err := core.NewBaseService(&core.ServiceOptions{URL: server.URL, Authenticator: &core.NoAuthAuthenticator{}})
+	assert.Nil(t, err)
+	EnableDebugLogging(service, logger)
+	EnableDebugLogging(service, logger)
+	_, ok := service.GetHTTPClient().Transport.(*DebugLogTransport).Next.(*DebugLogTransport)
+	assert.False(t, ok)
+
+	req, err := http.NewRequest("POST", server.URL, strings.NewReader(`{"value":"s1","type":"secure"}`))
+	assert.Nil(t, err)
+	req.Header.Set("Authorization", "Bearer s3")
+	res, err := service.GetHTTPClient().Do(req)
+	assert.Nil(t, err)
+	body, _ := io.ReadAll(res.Body)
+	assert.Equal(t, `{"parameters": {"api_key": "s2"}}`, string(body))
+
+	output := buf.String()
+	assert.True(t, strings.Contains(output, "Request: POST"))
+	assert.True(t, strings.Contains(output, "Response: 200 OK"))
+	for _, secret := range []string{"s1", "s2", "s3"} {
+		assert.False(t, strings.Contains(output, secret), secret)
+	}
+
+	DisableDebugLogging(service)
+	_, ok = service.GetHTTPClient().Transport.(*DebugLogTransport)
+	assert.False(t, ok)
+}
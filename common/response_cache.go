@@ -0,0 +1,360 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"bytes"
+	"container/list"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Defaults of ResponseCacheOptions.
+const (
+	DefaultResponseCacheTTL        = time.Minute
+	DefaultResponseCacheMaxEntries = 1000
+)
+
+// ResponseCacheOptions : The NewResponseCache options. Zero values select the defaults.
+type ResponseCacheOptions struct {
+	// The time a response is served from the cache.
+	TTL time.Duration
+
+	// The maximum number of responses kept. The least recently used response is evicted first.
+	MaxEntries int
+}
+
+// ResponseCache : A read-through cache of the successful responses to GET requests. Concurrent
+// requests for the same URL are coalesced into one request to the service. The responses of a
+// resource are evicted when a request that may modify it is sent: a PUT, PATCH or DELETE request
+// evicts the responses of the resource at its URL path, of the resources this path contains, and
+// of the resources that contain it; a POST request evicts the same responses except those of the
+// resources its path contains.
+//
+// A ResponseCache is installed on a client with the ResponseCache field of its options, and only
+// caches the lookups that the client documents. Clones of the client share its cache. As responses
+// are cached by URL, a cache must only be shared by clients that use the same credentials.
+type ResponseCache struct {
+	options ResponseCacheOptions
+
+	// mutex guards the fields below.
+	mutex   sync.Mutex
+	entries map[string]*list.Element
+	lru     *list.List
+	calls   map[string]*responseCacheCall
+	// generation changes with each eviction, so that a response received after its resource was
+	// modified is not stored.
+	generation uint64
+}
+
+// responseCacheEntry is a response stored in a ResponseCache.
+type responseCacheEntry struct {
+	key     string
+	path    string
+	expires time.Time
+	*cachedResponse
+}
+
+// cachedResponse is the content of a response, from which copies can be made.
+type cachedResponse struct {
+	res  *http.Response
+	body []byte
+}
+
+// responseCacheCall is a request being sent on behalf of concurrent callers.
+type responseCacheCall struct {
+	done     chan struct{}
+	response *cachedResponse
+	err      error
+}
+
+// NewResponseCache returns an empty ResponseCache with the given options.
+func NewResponseCache(options *ResponseCacheOptions) *ResponseCache {
+	cache := &ResponseCache{
+		entries: map[string]*list.Element{},
+		lru:     list.New(),
+		calls:   map[string]*responseCacheCall{},
+	}
+	if options != nil {
+		cache.options = *options
+	}
+	if cache.options.TTL <= 0 {
+		cache.options.TTL = DefaultResponseCacheTTL
+	}
+	if cache.options.MaxEntries <= 0 {
+		cache.options.MaxEntries = DefaultResponseCacheMaxEntries
+	}
+	return cache
+}
+
+// Len returns the number of responses in the cache, including expired ones not yet evicted.
+func (cache *ResponseCache) Len() int {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	return cache.lru.Len()
+}
+
+// Invalidate evicts the responses of the resource at a URL path, and of the resources it
+// contains or that contain it.
+func (cache *ResponseCache) Invalidate(path string) {
+	cache.invalidate(path, true)
+}
+
+// invalidate evicts the responses of the resource at a URL path and of the resources that
+// contain it, and also of the resources it contains if descendants is true.
+func (cache *ResponseCache) invalidate(path string, descendants bool) {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	cache.generation++
+	path = strings.TrimSuffix(path, "/")
+	for key, element := range cache.entries {
+		entryPath := element.Value.(*responseCacheEntry).path
+		if isPathWithin(path, entryPath) || (descendants && isPathWithin(entryPath, path)) {
+			cache.lru.Remove(element)
+			delete(cache.entries, key)
+		}
+	}
+}
+
+// Purge evicts every response.
+func (cache *ResponseCache) Purge() {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	cache.generation++
+	cache.entries = map[string]*list.Element{}
+	cache.lru.Init()
+}
+
+// responseCacheBypassKey is the context key set by WithoutResponseCache.
+type responseCacheBypassKey struct{}
+
+// WithoutResponseCache returns a copy of ctx with which the requests of a client are sent to the
+// service even if a response is in its ResponseCache. The response received replaces the cached
+// one. It is used by polling helpers, which would otherwise see the same state for up to the
+// TTL of the cache.
+func WithoutResponseCache(ctx context.Context) context.Context {
+	return context.WithValue(ctx, responseCacheBypassKey{}, true)
+}
+
+// isResponseCacheBypassed returns true if ctx was returned by WithoutResponseCache.
+func isResponseCacheBypassed(ctx context.Context) bool {
+	bypassed, _ := ctx.Value(responseCacheBypassKey{}).(bool)
+	return bypassed
+}
+
+// isPathWithin returns true if path is ancestor or one of its descendants.
+func isPathWithin(path string, ancestor string) bool {
+	return path == ancestor || strings.HasPrefix(path, ancestor+"/")
+}
+
+// get returns the response to req, from the cache, from a concurrent identical request, or else
+// from next.
+func (cache *ResponseCache) get(req *http.Request, next http.RoundTripper) (*http.Response, error) {
+	key := req.URL.String()
+	if isResponseCacheBypassed(req.Context()) {
+		return cache.refresh(req, next)
+	}
+	for {
+		cache.mutex.Lock()
+		if element, ok := cache.entries[key]; ok {
+			entry := element.Value.(*responseCacheEntry)
+			if time.Now().Before(entry.expires) {
+				cache.lru.MoveToFront(element)
+				cache.mutex.Unlock()
+				return entry.copy(req), nil
+			}
+			cache.lru.Remove(element)
+			delete(cache.entries, key)
+		}
+		call, ok := cache.calls[key]
+		if !ok {
+			break
+		}
+		cache.mutex.Unlock()
+		select {
+		case <-call.done:
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		}
+		if call.err == nil {
+			return call.response.copy(req), nil
+		}
+		// The request was sent with the context of the caller that started it, so its failure
+		// only applies to the other callers if it was not the context that ended it. Otherwise
+		// the next caller sends the request again.
+		if !isContextError(call.err) || req.Context().Err() != nil {
+			return nil, call.err
+		}
+	}
+	call := &responseCacheCall{done: make(chan struct{})}
+	cache.calls[key] = call
+	generation := cache.generation
+	cache.mutex.Unlock()
+
+	res, err := next.RoundTrip(req)
+	if err == nil {
+		var body []byte
+		body, err = io.ReadAll(res.Body)
+		res.Body.Close()
+		if err == nil {
+			call.response = &cachedResponse{res: res, body: body}
+		}
+	}
+	call.err = err
+
+	cache.mutex.Lock()
+	delete(cache.calls, key)
+	if err == nil && res.StatusCode == http.StatusOK && generation == cache.generation {
+		cache.store(req, call.response)
+	}
+	cache.mutex.Unlock()
+	close(call.done)
+	if err != nil {
+		return nil, err
+	}
+	return call.response.copy(req), nil
+}
+
+// refresh returns the response to req from next, and stores it in place of the cached one.
+func (cache *ResponseCache) refresh(req *http.Request, next http.RoundTripper) (*http.Response, error) {
+	cache.mutex.Lock()
+	generation := cache.generation
+	cache.mutex.Unlock()
+	res, err := next.RoundTrip(req)
+	if err != nil || res.StatusCode != http.StatusOK {
+		return res, err
+	}
+	body, err := io.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	response := &cachedResponse{res: res, body: body}
+	cache.mutex.Lock()
+	if generation == cache.generation {
+		cache.store(req, response)
+	}
+	cache.mutex.Unlock()
+	return response.copy(req), nil
+}
+
+// store adds a response to the cache, replacing the one stored before for the same URL. The
+// mutex must be held.
+func (cache *ResponseCache) store(req *http.Request, response *cachedResponse) {
+	key := req.URL.String()
+	if element, ok := cache.entries[key]; ok {
+		cache.lru.Remove(element)
+	}
+	cache.entries[key] = cache.lru.PushFront(&responseCacheEntry{
+		key:            key,
+		path:           strings.TrimSuffix(req.URL.Path, "/"),
+		expires:        time.Now().Add(cache.options.TTL),
+		cachedResponse: response,
+	})
+	for cache.lru.Len() > cache.options.MaxEntries {
+		oldest := cache.lru.Back()
+		cache.lru.Remove(oldest)
+		delete(cache.entries, oldest.Value.(*responseCacheEntry).key)
+	}
+}
+
+// isContextError returns true if err is the error of a cancelled or expired context.
+func isContextError(err error) bool {
+	return errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)
+}
+
+// copy returns a new response with the stored content, for req.
+func (response *cachedResponse) copy(req *http.Request) *http.Response {
+	res := *response.res
+	res.Header = response.res.Header.Clone()
+	res.Body = io.NopCloser(bytes.NewReader(response.body))
+	res.Request = req
+	return &res
+}
+
+// ResponseCacheTransport is an http.RoundTripper that serves the requests selected by Cacheable
+// through a ResponseCache, and evicts the responses of the resources modified by other requests.
+type ResponseCacheTransport struct {
+	// The transport used to send requests. If nil, http.DefaultTransport is used.
+	Next http.RoundTripper
+
+	// The cache of the responses. If nil, requests are not cached.
+	Cache *ResponseCache
+
+	// Reports whether the response to a GET request with this URL path can be cached.
+	Cacheable func(path string) bool
+}
+
+// RoundTrip implements http.RoundTripper.
+func (transport *ResponseCacheTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	next := transport.Next
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	cache := transport.Cache
+	switch {
+	case cache == nil || req.Method == http.MethodHead:
+		return next.RoundTrip(req)
+	case req.Method == http.MethodGet:
+		if transport.Cacheable == nil || !transport.Cacheable(req.URL.Path) {
+			return next.RoundTrip(req)
+		}
+		return cache.get(req, next)
+	default:
+		// A POST request creates a resource in a collection, or performs an action on a
+		// resource, so the other resources of the collection are left in the cache. Evicting
+		// again once the request completed discards the responses received while it was in
+		// progress.
+		descendants := req.Method != http.MethodPost
+		cache.invalidate(req.URL.Path, descendants)
+		defer cache.invalidate(req.URL.Path, descendants)
+		return next.RoundTrip(req)
+	}
+}
+
+func (transport *ResponseCacheTransport) layer() int {
+	return transportLayerResponseCache
+}
+
+func (transport *ResponseCacheTransport) next() http.RoundTripper {
+	return transport.Next
+}
+
+func (transport *ResponseCacheTransport) withNext(next http.RoundTripper) chainedTransport {
+	transportCopy := *transport
+	transportCopy.Next = next
+	return &transportCopy
+}
+
+// EnableResponseCaching installs a ResponseCacheTransport for cache on the service's HTTP client,
+// replacing one installed before. The HTTP client is copied rather than modified in place, so
+// clones of the service are not affected.
+func EnableResponseCaching(service *core.BaseService, cache *ResponseCache, cacheable func(path string) bool) {
+	installTransport(service, transportLayerResponseCache, &ResponseCacheTransport{Cache: cache, Cacheable: cacheable})
+}
+
+// DisableResponseCaching removes a ResponseCacheTransport installed by EnableResponseCaching.
+func DisableResponseCaching(service *core.BaseService) {
+	installTransport(service, transportLayerResponseCache, nil)
+}
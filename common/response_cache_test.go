@@ -0,0 +1,201 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResponseCache(t *testing.T) {
+	var gets int32
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		if req.Method == http.MethodGet {
+			n := atomic.AddInt32(&gets, 1)
+			if req.URL.Path == "/slow" {
+				<-release
+			}
+			if req.URL.Path == "/missing" {
+				res.WriteHeader(404)
+			}
+			fmt.Fprintf(res, "%s %d", req.URL.Path, n)
+		}
+	}))
+	defer server.Close()
+
+	service, err := core.NewBaseService(&core.ServiceOptions{URL: server.URL, Authenticator: &core.NoAuthAuthenticator{}})
+	assert.Nil(t, err)
+	cache := NewResponseCache(&ResponseCacheOptions{TTL: time.Hour, MaxEntries: 3})
+	EnableResponseCaching(service, cache, func(path string) bool { return path != "/uncached" })
+	EnableResponseCaching(service, cache, func(path string) bool { return path != "/uncached" })
+	_, ok := service.GetHTTPClient().Transport.(*ResponseCacheTransport).Next.(*ResponseCacheTransport)
+	assert.False(t, ok)
+	client := service.GetHTTPClient()
+	send := func(method string, path string) string {
+		req, err := http.NewRequest(method, server.URL+path, nil)
+		assert.Nil(t, err)
+		res, err := client.Do(req)
+		assert.Nil(t, err)
+		body, err := io.ReadAll(res.Body)
+		assert.Nil(t, err)
+		res.Body.Close()
+		return string(body)
+	}
+
+	assert.Equal(t, "/a 1", send("GET", "/a"))
+	assert.Equal(t, "/a 1", send("GET", "/a"))
+	assert.Equal(t, "/uncached 2", send("GET", "/uncached"))
+	assert.Equal(t, "/missing 3", send("GET", "/missing"))
+	assert.Equal(t, "/missing 4", send("GET", "/missing"))
+	assert.Equal(t, 1, cache.Len())
+
+	// Modifying a resource evicts it, its descendants and its ancestors.
+	for _, path := range []string{"/a/b", "/a/b/c", "/a/bc", "/d"} {
+		send("GET", path)
+	}
+	assert.Equal(t, 3, cache.Len())
+	assert.Equal(t, "/a/bc 7", send("GET", "/a/bc"))
+	send("PATCH", "/a/b")
+	assert.Equal(t, "/a/bc 7", send("GET", "/a/bc"))
+	assert.Equal(t, "/a/b 9", send("GET", "/a/b"))
+	send("GET", "/a/b/c")
+	send("POST", "/a/b/c/d")
+	assert.Equal(t, "/a/b/c 11", send("GET", "/a/b/c"))
+	assert.Equal(t, "/a/bc 7", send("GET", "/a/bc"))
+	send("POST", "/a")
+	assert.Equal(t, "/a/bc 7", send("GET", "/a/bc"))
+	cache.Purge()
+	assert.Equal(t, 0, cache.Len())
+
+	// Concurrent requests for the same URL are coalesced.
+	var wg sync.WaitGroup
+	bodies := make([]string, 5)
+	for i := range bodies {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			bodies[i] = send("GET", "/slow")
+		}()
+	}
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+	assert.Equal(t, strings.Repeat("/slow 12,", 5), strings.Join(bodies, ",")+",")
+
+	DisableResponseCaching(service)
+	_, ok = service.GetHTTPClient().Transport.(*ResponseCacheTransport)
+	assert.False(t, ok)
+}
+
+func TestResponseCacheExpiry(t *testing.T) {
+	var gets int32
+	server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		fmt.Fprint(res, atomic.AddInt32(&gets, 1))
+	}))
+	defer server.Close()
+
+	cache := NewResponseCache(&ResponseCacheOptions{TTL: 20 * time.Millisecond})
+	client := &http.Client{Transport: &ResponseCacheTransport{Cache: cache, Cacheable: func(string) bool { return true }}}
+	get := func() string {
+		res, err := client.Get(server.URL + "/a")
+		assert.Nil(t, err)
+		body, _ := io.ReadAll(res.Body)
+		return string(body)
+	}
+	assert.Equal(t, "1", get())
+	assert.Equal(t, "1", get())
+	time.Sleep(30 * time.Millisecond)
+	assert.Equal(t, "2", get())
+}
+
+func TestResponseCacheCancelledLeader(t *testing.T) {
+	var gets int32
+	started := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		if atomic.AddInt32(&gets, 1) == 1 {
+			close(started)
+			<-req.Context().Done()
+			return
+		}
+		fmt.Fprint(res, "ok")
+	}))
+	defer server.Close()
+
+	cache := NewResponseCache(nil)
+	client := &http.Client{Transport: &ResponseCacheTransport{Cache: cache, Cacheable: func(string) bool { return true }}}
+	ctx, cancel := context.WithCancel(context.Background())
+	leader := make(chan error)
+	go func() {
+		req, _ := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+"/a", nil)
+		_, err := client.Do(req)
+		leader <- err
+	}()
+	<-started
+
+	follower := make(chan string)
+	go func() {
+		res, err := client.Get(server.URL + "/a")
+		assert.Nil(t, err)
+		body, _ := io.ReadAll(res.Body)
+		follower <- string(body)
+	}()
+	time.Sleep(50 * time.Millisecond)
+	cancel()
+
+	// The leader fails with its own context, while the follower sends the request again.
+	assert.ErrorIs(t, <-leader, context.Canceled)
+	assert.Equal(t, "ok", <-follower)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&gets))
+	assert.Equal(t, 1, cache.Len())
+}
+
+func TestResponseCacheBypass(t *testing.T) {
+	var gets int32
+	server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		fmt.Fprint(res, atomic.AddInt32(&gets, 1))
+	}))
+	defer server.Close()
+
+	cache := NewResponseCache(&ResponseCacheOptions{TTL: time.Hour})
+	client := &http.Client{Transport: &ResponseCacheTransport{Cache: cache, Cacheable: func(string) bool { return true }}}
+	get := func(ctx context.Context) string {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+"/a", nil)
+		assert.Nil(t, err)
+		res, err := client.Do(req)
+		assert.Nil(t, err)
+		body, _ := io.ReadAll(res.Body)
+		return string(body)
+	}
+	assert.Equal(t, "1", get(context.Background()))
+	assert.Equal(t, "2", get(WithoutResponseCache(context.Background())))
+	assert.Equal(t, "3", get(WithoutResponseCache(context.Background())))
+	// The response received replaced the cached one.
+	assert.Equal(t, "3", get(context.Background()))
+	assert.Equal(t, 1, cache.Len())
+}
@@ -0,0 +1,85 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// ResponseHookTransport is an http.RoundTripper that passes the responses selected by Hooked,
+// together with their body, to Hook before returning them.
+type ResponseHookTransport struct {
+	// The transport used to send requests. If nil, http.DefaultTransport is used.
+	Next http.RoundTripper
+
+	// Reports whether the response to req is passed to Hook. Only the bodies of the selected
+	// responses are read into memory.
+	Hooked func(req *http.Request, res *http.Response) bool
+
+	// Called with the selected responses and their body, which must not be modified.
+	Hook func(req *http.Request, res *http.Response, body []byte)
+}
+
+// RoundTrip implements http.RoundTripper.
+func (transport *ResponseHookTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	next := transport.Next
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	res, err := next.RoundTrip(req)
+	if err != nil || transport.Hooked == nil || transport.Hook == nil || !transport.Hooked(req, res) {
+		return res, err
+	}
+	body, err := io.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	res.Body = io.NopCloser(bytes.NewReader(body))
+	transport.Hook(req, res, body)
+	return res, nil
+}
+
+func (transport *ResponseHookTransport) layer() int {
+	return transportLayerResponseHook
+}
+
+func (transport *ResponseHookTransport) next() http.RoundTripper {
+	return transport.Next
+}
+
+func (transport *ResponseHookTransport) withNext(next http.RoundTripper) chainedTransport {
+	transportCopy := *transport
+	transportCopy.Next = next
+	return &transportCopy
+}
+
+// EnableResponseHook installs a ResponseHookTransport on the service's HTTP client, replacing one
+// installed before. The HTTP client is copied rather than modified in place, so clones of the
+// service are not affected.
+func EnableResponseHook(service *core.BaseService, hooked func(req *http.Request, res *http.Response) bool, hook func(req *http.Request, res *http.Response, body []byte)) {
+	installTransport(service, transportLayerResponseHook, &ResponseHookTransport{Hooked: hooked, Hook: hook})
+}
+
+// DisableResponseHook removes a ResponseHookTransport installed by EnableResponseHook.
+func DisableResponseHook(service *core.BaseService) {
+	installTransport(service, transportLayerResponseHook, nil)
+}
@@ -0,0 +1,66 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResponseHook(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		res.Header().Set("Content-Type", "application/json")
+		_, _ = res.Write([]byte(`{"path": "` + req.URL.Path + `"}`))
+	}))
+	defer server.Close()
+
+	service, err := core.NewBaseService(&core.ServiceOptions{URL: server.URL, Authenticator: &core.NoAuthAuthenticator{}})
+	assert.Nil(t, err)
+	var bodies []string
+	EnableResponseHook(service, func(req *http.Request, res *http.Response) bool {
+		return req.Method == http.MethodPost
+	}, func(req *http.Request, res *http.Response, body []byte) {
+		bodies = append(bodies, string(body))
+	})
+	send := func(method string) map[string]interface{} {
+		req, _ := http.NewRequest(method, server.URL+"/runs", nil)
+		var result map[string]interface{}
+		_, err := service.Request(req, &result)
+		assert.Nil(t, err)
+		return result
+	}
+
+	// The body passed to the hook is still returned with the response.
+	assert.Equal(t, map[string]interface{}{"path": "/runs"}, send(http.MethodPost))
+	assert.Equal(t, map[string]interface{}{"path": "/runs"}, send(http.MethodGet))
+	assert.Equal(t, []string{`{"path": "/runs"}`}, bodies)
+
+	DisableResponseHook(service)
+	send(http.MethodPost)
+	assert.Len(t, bodies, 1)
+
+	transport := &ResponseHookTransport{Next: server.Client().Transport}
+	res, err := transport.RoundTrip(httptest.NewRequest(http.MethodPost, server.URL+"/runs", nil))
+	assert.Nil(t, err)
+	body, _ := io.ReadAll(res.Body)
+	assert.Equal(t, `{"path": "/runs"}`, string(body))
+}
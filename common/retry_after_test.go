@@ -0,0 +1,56 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetryAfter(t *testing.T) {
+	now := time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC)
+	header := func(value string) http.Header {
+		return http.Header{"Retry-After": []string{value}}
+	}
+
+	delay, ok := RetryAfter(header("3"), now)
+	assert.True(t, ok)
+	assert.Equal(t, 3*time.Second, delay)
+
+	delay, ok = RetryAfter(header("Sat, 01 Mar 2025 10:00:30 GMT"), now)
+	assert.True(t, ok)
+	assert.Equal(t, 30*time.Second, delay)
+
+	delay, ok = RetryAfter(header("Sat, 01 Mar 2025 09:00:00 GMT"), now)
+	assert.True(t, ok)
+	assert.Zero(t, delay)
+
+	for _, value := range []string{"", "-1", "soon"} {
+		_, ok = RetryAfter(header(value), now)
+		assert.False(t, ok, value)
+	}
+}
+
+func TestIsRetryableStatus(t *testing.T) {
+	assert.True(t, IsRetryableStatus(429))
+	assert.True(t, IsRetryableStatus(503))
+	assert.False(t, IsRetryableStatus(501))
+	assert.False(t, IsRetryableStatus(404))
+}
@@ -0,0 +1,350 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Attribute : A key-value pair attached to spans and measurements.
+type Attribute struct {
+	Key   string
+	Value interface{}
+}
+
+// Tracer : Starts spans. It mirrors the OpenTelemetry trace.Tracer; the submodule
+// github.com/IBM/continuous-delivery-go-sdk/v2/common/otel adapts OpenTelemetry tracers.
+type Tracer interface {
+	// Start starts a span as a child of the span in ctx, if any, and returns a context holding it.
+	Start(ctx context.Context, spanName string) (context.Context, Span)
+}
+
+// Span : An operation traced by a Tracer.
+type Span interface {
+	SetAttributes(attributes ...Attribute)
+	RecordError(err error)
+	End()
+
+	// TraceContext returns the identity of the span, propagated to the service.
+	TraceContext() TraceContext
+}
+
+// Float64Histogram : Records measurements. It mirrors the OpenTelemetry metric.Float64Histogram.
+type Float64Histogram interface {
+	Record(ctx context.Context, value float64, attributes ...Attribute)
+}
+
+// Int64Counter : Counts events. It mirrors the OpenTelemetry metric.Int64Counter.
+type Int64Counter interface {
+	Add(ctx context.Context, incr int64, attributes ...Attribute)
+}
+
+// TraceContext : The identity of a span, as propagated by the W3C Trace Context traceparent and
+// tracestate headers.
+type TraceContext struct {
+	TraceID    [16]byte
+	SpanID     [8]byte
+	Sampled    bool
+	TraceState string
+}
+
+// IsValid returns true if the trace and span IDs are set.
+func (traceContext TraceContext) IsValid() bool {
+	return traceContext.TraceID != [16]byte{} && traceContext.SpanID != [8]byte{}
+}
+
+// TraceParent returns the value of the traceparent header for the span.
+func (traceContext TraceContext) TraceParent() string {
+	flags := "00"
+	if traceContext.Sampled {
+		flags = "01"
+	}
+	return "00-" + hex.EncodeToString(traceContext.TraceID[:]) + "-" + hex.EncodeToString(traceContext.SpanID[:]) + "-" + flags
+}
+
+// Attribute keys set by Telemetry. The HTTP keys follow the OpenTelemetry semantic conventions.
+const (
+	AttributeOperation       = "cd.operation"
+	AttributeToolchainID     = "cd.toolchain.id"
+	AttributeToolID          = "cd.tool.id"
+	AttributePipelineID      = "cd.pipeline.id"
+	AttributePipelineRunID   = "cd.pipeline_run.id"
+	AttributeTriggerID       = "cd.trigger.id"
+	AttributeHTTPMethod      = "http.request.method"
+	AttributeHTTPStatusCode  = "http.response.status_code"
+	AttributeHTTPResendCount = "http.request.resend_count"
+	AttributeErrorType       = "error.type"
+)
+
+// pathAttributes maps the collections of the URL paths to the attributes of their resource IDs.
+var pathAttributes = map[string]string{
+	"toolchains":       AttributeToolchainID,
+	"tools":            AttributeToolID,
+	"tekton_pipelines": AttributePipelineID,
+	"pipeline_runs":    AttributePipelineRunID,
+	"triggers":         AttributeTriggerID,
+}
+
+// TelemetryOptions : The NewTelemetry options. Nil fields disable the corresponding signal.
+type TelemetryOptions struct {
+	// Starts one span per request, named after the operation ID, for example "GetToolchainByID".
+	Tracer Tracer
+
+	// Records the duration of each request in seconds.
+	RequestDuration Float64Histogram
+
+	// Counts the requests that failed, with an error or an error status.
+	RequestErrors Int64Counter
+
+	// Logs each request with its operation ID, method, path, status code, duration and resend count.
+	Logger *slog.Logger
+
+	// The level of the records of the requests that succeeded. Defaults to slog.LevelDebug.
+	Level slog.Leveler
+
+	// The level of the records of the requests that failed. Defaults to slog.LevelWarn.
+	ErrorLevel slog.Leveler
+
+	// Adds the request and response bodies to the records, with secrets redacted.
+	LogBodies bool
+
+	// Injects the trace context of ctx, which holds the span of the request if Tracer is set,
+	// into the headers of the request, for example with an OpenTelemetry TextMapPropagator. By
+	// default, the traceparent and tracestate headers are set from the TraceContext of the span.
+	Propagate func(ctx context.Context, header http.Header)
+}
+
+// Telemetry : Traces, measures and logs the operations of a client. A Telemetry is installed on a client
+// with the Telemetry field of its options, which installs a TelemetryTransport shared by the
+// clones of the client.
+//
+// Each request sent for an operation gets one span, named after the operation, with the IDs of
+// the resources in its URL path, the HTTP method and status code. A request resent by the retries
+// enabled with EnableRetries gets a span of its own, with the number of times it was sent before
+// as the http.request.resend_count attribute, as the OpenTelemetry semantic conventions specify.
+// The span is propagated to the service with TelemetryOptions.Propagate, or by default with the
+// W3C traceparent and tracestate headers. The submodule
+// github.com/IBM/continuous-delivery-go-sdk/v2/common/otel provides the options for
+// OpenTelemetry. The duration and error metrics have the operation, method and status code
+// attributes. The log records carry the same information as the spans, with secrets redacted.
+type Telemetry struct {
+	options TelemetryOptions
+}
+
+// NewTelemetry returns a Telemetry with the given options.
+func NewTelemetry(options *TelemetryOptions) *Telemetry {
+	telemetry := &Telemetry{}
+	if options != nil {
+		telemetry.options = *options
+	}
+	if telemetry.options.Level == nil {
+		telemetry.options.Level = slog.LevelDebug
+	}
+	if telemetry.options.ErrorLevel == nil {
+		telemetry.options.ErrorLevel = slog.LevelWarn
+	}
+	return telemetry
+}
+
+// resendCountHeader carries the number of times a request was sent by TelemetryTransport, from one
+// attempt of the retries enabled with EnableRetries to the next, which send the same request. It
+// is removed from the requests sent to the service.
+const resendCountHeader = "X-Cd-Sdk-Resend-Count"
+
+// TelemetryTransport is an http.RoundTripper that traces, measures and logs the requests sent for
+// the operations of a client with a Telemetry. The operation of a request is read from the
+// X-IBMCloud-SDK-Analytics header set by GetSdkHeaders.
+type TelemetryTransport struct {
+	// The transport used to send requests. If nil, http.DefaultTransport is used.
+	Next http.RoundTripper
+
+	// The telemetry of the requests. If nil, requests are sent as is.
+	Telemetry *Telemetry
+}
+
+// RoundTrip implements http.RoundTripper.
+func (transport *TelemetryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	next := transport.Next
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	telemetry := transport.Telemetry
+	if telemetry == nil {
+		return next.RoundTrip(req)
+	}
+	// The retries enabled with EnableRetries send the same request again, so the count is kept
+	// in its headers.
+	resendCount, _ := strconv.Atoi(req.Header.Get(resendCountHeader))
+	req.Header.Set(resendCountHeader, strconv.Itoa(resendCount+1))
+
+	operationID := operationIDFromHeader(req.Header)
+	if operationID == "" {
+		operationID = req.Method
+	}
+	ctx := req.Context()
+	attributes := []Attribute{{AttributeOperation, operationID}, {AttributeHTTPMethod, req.Method}}
+
+	var span Span
+	if telemetry.options.Tracer != nil {
+		ctx, span = telemetry.options.Tracer.Start(ctx, operationID)
+		span.SetAttributes(attributes...)
+		span.SetAttributes(resourceAttributes(req.URL.Path)...)
+	}
+	outgoing := req.Clone(ctx)
+	outgoing.Header.Del(resendCountHeader)
+	if telemetry.options.Propagate != nil {
+		telemetry.options.Propagate(ctx, outgoing.Header)
+	} else if span != nil {
+		if traceContext := span.TraceContext(); traceContext.IsValid() {
+			outgoing.Header.Set("traceparent", traceContext.TraceParent())
+			if traceContext.TraceState != "" {
+				outgoing.Header.Set("tracestate", traceContext.TraceState)
+			} else {
+				outgoing.Header.Del("tracestate")
+			}
+		}
+	}
+	var requestBody []byte
+	if telemetry.options.Logger != nil && telemetry.options.LogBodies && req.GetBody != nil {
+		if body, bodyErr := req.GetBody(); bodyErr == nil {
+			requestBody, _ = io.ReadAll(body)
+			body.Close()
+		}
+	}
+
+	start := time.Now()
+	res, err := next.RoundTrip(outgoing)
+	duration := time.Since(start)
+
+	// The body of an error response is read for its message, and that of any response for the
+	// log if bodies are logged.
+	var responseBody []byte
+	if err == nil && (res.StatusCode >= 400 || (telemetry.options.Logger != nil && telemetry.options.LogBodies)) {
+		responseBody, err = io.ReadAll(res.Body)
+		res.Body.Close()
+		if err != nil {
+			res = nil
+		} else {
+			res.Body = io.NopCloser(bytes.NewReader(responseBody))
+		}
+	}
+	var outcome []Attribute
+	failure := err
+	if res != nil {
+		outcome = append(outcome, Attribute{AttributeHTTPStatusCode, res.StatusCode})
+		if res.StatusCode >= 400 {
+			failure = errors.New(errorResponseMessage(res, responseBody))
+			outcome = append(outcome, Attribute{AttributeErrorType, strconv.Itoa(res.StatusCode)})
+		}
+	} else {
+		outcome = append(outcome, Attribute{AttributeErrorType, "error"})
+	}
+	if span != nil {
+		span.SetAttributes(outcome...)
+		span.SetAttributes(Attribute{AttributeHTTPResendCount, resendCount})
+		if failure != nil {
+			span.RecordError(failure)
+		}
+		span.End()
+	}
+	attributes = append(attributes, outcome...)
+	if telemetry.options.RequestDuration != nil {
+		telemetry.options.RequestDuration.Record(ctx, duration.Seconds(), attributes...)
+	}
+	if failure != nil && telemetry.options.RequestErrors != nil {
+		telemetry.options.RequestErrors.Add(ctx, 1, attributes...)
+	}
+	if telemetry.options.Logger != nil {
+		telemetry.logRequest(ctx, operationID, req, requestBody, res, responseBody, failure, duration, resendCount)
+	}
+	return res, err
+}
+
+func (transport *TelemetryTransport) layer() int {
+	return transportLayerTelemetry
+}
+
+func (transport *TelemetryTransport) next() http.RoundTripper {
+	return transport.Next
+}
+
+func (transport *TelemetryTransport) withNext(next http.RoundTripper) chainedTransport {
+	transportCopy := *transport
+	transportCopy.Next = next
+	return &transportCopy
+}
+
+// EnableTelemetry installs a TelemetryTransport for telemetry on the service's HTTP client,
+// replacing one installed before. The HTTP client is copied rather than modified in place, so
+// clones of the service are not affected.
+func EnableTelemetry(service *core.BaseService, telemetry *Telemetry) {
+	installTransport(service, transportLayerTelemetry, &TelemetryTransport{Telemetry: telemetry})
+}
+
+// DisableTelemetry removes a TelemetryTransport installed by EnableTelemetry.
+func DisableTelemetry(service *core.BaseService) {
+	installTransport(service, transportLayerTelemetry, nil)
+}
+
+// operationIDFromHeader returns the operation_id of the X-IBMCloud-SDK-Analytics header, or "".
+func operationIDFromHeader(header http.Header) string {
+	// core.RequestBuilder sets the header under its name as given, which is not canonical.
+	value := header.Get(headerNameSdkAnalytics)
+	if values := header[headerNameSdkAnalytics]; len(values) > 0 {
+		value = values[0]
+	}
+	for _, field := range strings.Split(value, ";") {
+		if value, ok := strings.CutPrefix(strings.TrimSpace(field), "operation_id="); ok {
+			return value
+		}
+	}
+	return ""
+}
+
+// errorResponseMessage returns the message of the first error of an error response body, or else
+// the status of the response.
+func errorResponseMessage(res *http.Response, body []byte) string {
+	var errorBody apiErrorBody
+	if json.Unmarshal(body, &errorBody) == nil && len(errorBody.Errors) > 0 && errorBody.Errors[0].Message != "" {
+		return errorBody.Errors[0].Message
+	}
+	return res.Status
+}
+
+// resourceAttributes returns the attributes of the resource IDs in a URL path.
+func resourceAttributes(path string) (attributes []Attribute) {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	for i := 0; i+1 < len(segments); i++ {
+		if key, ok := pathAttributes[segments[i]]; ok {
+			attributes = append(attributes, Attribute{key, segments[i+1]})
+			i++
+		}
+	}
+	return
+}
@@ -0,0 +1,209 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/stretchr/testify/assert"
+)
+
+type testSpan struct {
+	name       string
+	attributes map[string]interface{}
+	err        error
+	ended      bool
+}
+
+func (span *testSpan) SetAttributes(attributes ...Attribute) {
+	for _, attribute := range attributes {
+		span.attributes[attribute.Key] = attribute.Value
+	}
+}
+func (span *testSpan) RecordError(err error) { span.err = err }
+func (span *testSpan) End()                  { span.ended = true }
+func (span *testSpan) TraceContext() TraceContext {
+	return TraceContext{TraceID: [16]byte{0xab, 15: 1}, SpanID: [8]byte{0xcd, 7: 2}, Sampled: true, TraceState: "k=v"}
+}
+
+type testTracer struct{ spans []*testSpan }
+
+func (tracer *testTracer) Start(ctx context.Context, spanName string) (context.Context, Span) {
+	span := &testSpan{name: spanName, attributes: map[string]interface{}{}}
+	tracer.spans = append(tracer.spans, span)
+	return ctx, span
+}
+
+type testInstrument struct {
+	values     []float64
+	attributes [][]Attribute
+}
+
+func (instrument *testInstrument) Record(ctx context.Context, value float64, attributes ...Attribute) {
+	instrument.values = append(instrument.values, value)
+	instrument.attributes = append(instrument.attributes, attributes)
+}
+func (instrument *testInstrument) Add(ctx context.Context, incr int64, attributes ...Attribute) {
+	instrument.Record(ctx, float64(incr), attributes...)
+}
+
+func TestTraceParent(t *testing.T) {
+	assert.False(t, TraceContext{}.IsValid())
+	traceContext := (&testSpan{}).TraceContext()
+	assert.True(t, traceContext.IsValid())
+	assert.Equal(t, "00-ab000000000000000000000000000001-cd00000000000002-01", traceContext.TraceParent())
+	traceContext.Sampled = false
+	assert.Equal(t, "00-ab000000000000000000000000000001-cd00000000000002-00", traceContext.TraceParent())
+}
+
+// sendOperation sends req with service.Request, with the headers that a generated operation adds
+// with core.RequestBuilder.
+func sendOperation(service *core.BaseService, operationID string, req *http.Request, result interface{}) (*core.DetailedResponse, error) {
+	for name, value := range GetSdkHeaders("cd_test", "V2", operationID) {
+		req.Header[name] = []string{value}
+	}
+	return service.Request(req, result)
+}
+
+func TestTelemetry(t *testing.T) {
+	var calls int32
+	var traceParents []string
+	server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		traceParents = append(traceParents, req.Header.Get("traceparent")+" "+req.Header.Get("tracestate"))
+		assert.Empty(t, req.Header.Get(resendCountHeader))
+		res.Header().Set("Content-Type", "application/json")
+		switch {
+		case req.URL.Path == "/missing":
+			res.WriteHeader(404)
+			_, _ = res.Write([]byte(`{"errors": [{"message": "not found"}]}`))
+		case atomic.AddInt32(&calls, 1) == 1:
+			res.WriteHeader(503)
+		default:
+			_, _ = res.Write([]byte(`{"id": "r1"}`))
+		}
+	}))
+	defer server.Close()
+
+	service, err := core.NewBaseService(&core.ServiceOptions{URL: server.URL, Authenticator: &core.NoAuthAuthenticator{}})
+	assert.Nil(t, err)
+	service.EnableRetries(2, time.Millisecond)
+	tracer := &testTracer{}
+	durations, errorCounts := &testInstrument{}, &testInstrument{}
+	EnableTelemetry(service, NewTelemetry(&TelemetryOptions{Tracer: tracer, RequestDuration: durations, RequestErrors: errorCounts}))
+	request := func(path string) (*core.DetailedResponse, error) {
+		builder := core.NewRequestBuilder(core.GET)
+		_, err := builder.ResolveRequestURL(server.URL, path, nil)
+		assert.Nil(t, err)
+		req, err := builder.Build()
+		assert.Nil(t, err)
+		var result map[string]interface{}
+		return sendOperation(service, "GetTektonPipelineRun", req, &result)
+	}
+
+	// The request resent by the retries gets a span of its own.
+	response, err := request("/tekton_pipelines/p1/pipeline_runs/r1")
+	assert.Nil(t, err)
+	assert.Equal(t, 200, response.StatusCode)
+	assert.Len(t, tracer.spans, 2)
+	span := tracer.spans[0]
+	assert.Equal(t, "GetTektonPipelineRun", span.name)
+	assert.True(t, span.ended)
+	assert.Equal(t, "503 Service Unavailable", span.err.Error())
+	assert.Equal(t, 0, span.attributes[AttributeHTTPResendCount])
+	span = tracer.spans[1]
+	assert.True(t, span.ended)
+	assert.Nil(t, span.err)
+	assert.Equal(t, map[string]interface{}{
+		AttributeOperation:       "GetTektonPipelineRun",
+		AttributeHTTPMethod:      "GET",
+		AttributePipelineID:      "p1",
+		AttributePipelineRunID:   "r1",
+		AttributeHTTPStatusCode:  200,
+		AttributeHTTPResendCount: 1,
+	}, span.attributes)
+	assert.Equal(t, []string{
+		"00-ab000000000000000000000000000001-cd00000000000002-01 k=v",
+		"00-ab000000000000000000000000000001-cd00000000000002-01 k=v",
+	}, traceParents)
+	assert.Len(t, durations.values, 2)
+	assert.Equal(t, []float64{1}, errorCounts.values)
+
+	_, err = request("/missing")
+	assert.NotNil(t, err)
+	span = tracer.spans[2]
+	assert.Equal(t, "not found", span.err.Error())
+	assert.Equal(t, "404", span.attributes[AttributeErrorType])
+	assert.Equal(t, 0, span.attributes[AttributeHTTPResendCount])
+	assert.Equal(t, []float64{1, 1}, errorCounts.values)
+	assert.Equal(t, []Attribute{
+		{AttributeOperation, "GetTektonPipelineRun"}, {AttributeHTTPMethod, "GET"},
+		{AttributeHTTPStatusCode, 404}, {AttributeErrorType, "404"},
+	}, errorCounts.attributes[1])
+
+	// Without a Telemetry, requests are sent as is.
+	DisableTelemetry(service)
+	response, err = request("/tekton_pipelines/p1")
+	assert.Nil(t, err)
+	assert.Equal(t, 200, response.StatusCode)
+	assert.Len(t, tracer.spans, 3)
+}
+
+type testSpanKey struct{}
+
+type testContextTracer struct{}
+
+func (testContextTracer) Start(ctx context.Context, spanName string) (context.Context, Span) {
+	return context.WithValue(ctx, testSpanKey{}, spanName), &testSpan{attributes: map[string]interface{}{}}
+}
+
+func TestTelemetryPropagate(t *testing.T) {
+	var headers []http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		headers = append(headers, req.Header.Clone())
+	}))
+	defer server.Close()
+
+	service, err := core.NewBaseService(&core.ServiceOptions{URL: server.URL, Authenticator: &core.NoAuthAuthenticator{}})
+	assert.Nil(t, err)
+	propagate := func(ctx context.Context, header http.Header) {
+		if spanName, ok := ctx.Value(testSpanKey{}).(string); ok {
+			header.Set("x-span", spanName)
+		}
+		header.Set("baggage", "k=v")
+	}
+	for _, tracer := range []Tracer{testContextTracer{}, nil} {
+		EnableTelemetry(service, NewTelemetry(&TelemetryOptions{Tracer: tracer, Propagate: propagate}))
+		req, _ := http.NewRequest("GET", server.URL+"/toolchains/tc1", nil)
+		_, err = sendOperation(service, "GetToolchainByID", req, nil)
+		assert.Nil(t, err)
+	}
+
+	// The span of the request is propagated instead of the default headers, and the
+	// propagation applies without a tracer too.
+	assert.Len(t, headers, 2)
+	assert.Equal(t, "GetToolchainByID", headers[0].Get("x-span"))
+	assert.Empty(t, headers[0].Get("traceparent"))
+	assert.Equal(t, "k=v", headers[0].Get("baggage"))
+	assert.Empty(t, headers[1].Get("x-span"))
+	assert.Equal(t, "k=v", headers[1].Get("baggage"))
+}
@@ -0,0 +1,195 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"crypto/tls"
+	"net/http"
+	"sort"
+	"strconv"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+// The transports installed by this package, outermost first. Telemetry sits outermost so that it
+// measures the requests as the client sees them, response hooks see the responses returned to
+// the client, response caching sits outside rate limiting so that cache hits are not throttled,
+// and debug logging sits innermost so that it logs each request as it is sent.
+const (
+	transportLayerTelemetry = iota
+	transportLayerResponseHook
+	transportLayerResponseCache
+	transportLayerRateLimit
+	transportLayerDebugLog
+)
+
+// chainedTransport is a transport that this package installs in the transport chain of a
+// service's HTTP client.
+type chainedTransport interface {
+	http.RoundTripper
+
+	// layer returns the position of the transport in the chain.
+	layer() int
+
+	// next returns the transport that the transport sends requests to.
+	next() http.RoundTripper
+
+	// withNext returns a copy of the transport that sends requests to next.
+	withNext(next http.RoundTripper) chainedTransport
+}
+
+// installTransport installs transport in the transport chain of the service's HTTP client at
+// the position given by its layer, replacing a transport installed before at that position.
+// If transport is nil, the transport at the specified layer is removed instead.
+//
+// The transports of the chain are copied rather than modified in place, and so is the HTTP
+// client, so a clone of the service sharing the chain is not affected.
+func installTransport(service *core.BaseService, layer int, transport chainedTransport) {
+	client := service.GetHTTPClient()
+	if client == nil {
+		if transport == nil {
+			return
+		}
+		client = core.DefaultHTTPClient()
+	}
+
+	var chain []chainedTransport
+	removed := false
+	base := client.Transport
+	for {
+		link, ok := base.(chainedTransport)
+		if !ok {
+			break
+		}
+		if link.layer() == layer {
+			removed = true
+		} else {
+			chain = append(chain, link)
+		}
+		base = link.next()
+	}
+	if transport == nil && !removed {
+		return
+	}
+	if transport != nil {
+		chain = append(chain, transport)
+	}
+	sort.SliceStable(chain, func(i, j int) bool {
+		return chain[i].layer() < chain[j].layer()
+	})
+
+	next := base
+	for i := len(chain) - 1; i >= 0; i-- {
+		next = chain[i].withNext(next)
+	}
+	clientCopy := *client
+	clientCopy.Transport = next
+	setHTTPClient(service, &clientCopy)
+}
+
+// setHTTPClient is BaseService.SetHTTPClient, except that when retries are enabled the service
+// is given its own copy of the retryable client before the HTTP client embedded in it is
+// replaced. BaseService.Clone shares the retryable client between the clones, so replacing
+// the embedded client in place would change the original service too.
+func setHTTPClient(service *core.BaseService, client *http.Client) {
+	if service.Client != nil {
+		if roundTripper, ok := service.Client.Transport.(*retryablehttp.RoundTripper); ok {
+			retryable := roundTripper.Client
+			shim := *service.Client
+			shim.Transport = &retryablehttp.RoundTripper{
+				Client: &retryablehttp.Client{
+					HTTPClient:      retryable.HTTPClient,
+					Logger:          retryable.Logger,
+					RetryWaitMin:    retryable.RetryWaitMin,
+					RetryWaitMax:    retryable.RetryWaitMax,
+					RetryMax:        retryable.RetryMax,
+					RequestLogHook:  retryable.RequestLogHook,
+					ResponseLogHook: retryable.ResponseLogHook,
+					CheckRetry:      retryable.CheckRetry,
+					Backoff:         retryable.Backoff,
+					ErrorHandler:    retryable.ErrorHandler,
+					PrepareRetry:    retryable.PrepareRetry,
+				},
+			}
+			service.Client = &shim
+		}
+	}
+	service.SetHTTPClient(client)
+}
+
+// baseTransport returns the transport underneath the transports installed by this package in
+// the transport chain of client, if it is an *http.Transport.
+func baseTransport(client *http.Client) *http.Transport {
+	if client == nil {
+		return nil
+	}
+	next := client.Transport
+	for {
+		link, ok := next.(chainedTransport)
+		if !ok {
+			break
+		}
+		next = link.next()
+	}
+	transport, _ := next.(*http.Transport)
+	return transport
+}
+
+// ConfigureService configures the service from external configuration, like
+// BaseService.ConfigureService. Unlike that method, it also honors the DISABLE_SSL property
+// when transports were installed by this package, as BaseService.ConfigureService looks for
+// an *http.Transport at the top of the transport chain only.
+func ConfigureService(service *core.BaseService, serviceName string) error {
+	err := service.ConfigureService(serviceName)
+	if err != nil {
+		return err
+	}
+	serviceProps, err := core.GetServiceProperties(serviceName)
+	if err != nil {
+		return err
+	}
+	if disableSSL, _ := strconv.ParseBool(serviceProps[core.PROPNAME_SVC_DISABLE_SSL]); disableSSL {
+		DisableSSLVerification(service)
+	}
+	return nil
+}
+
+// DisableSSLVerification configures the service to skip the verification of server certificates
+// and hostnames, like BaseService.DisableSSLVerification. Unlike that method, it also reaches
+// the *http.Transport underneath the transports installed by this package, such as those of
+// EnableDebugLogging, EnableRateLimiting and EnableResponseCaching. Once one of those is
+// installed, BaseService.DisableSSLVerification and BaseService.IsSSLDisabled no longer see the
+// *http.Transport, so this function and IsSSLDisabled must be used instead.
+func DisableSSLVerification(service *core.BaseService) {
+	service.DisableSSLVerification()
+	if transport := baseTransport(service.GetHTTPClient()); transport != nil {
+		if transport.TLSClientConfig == nil {
+			transport.TLSClientConfig = &tls.Config{} // #nosec G402
+		}
+		transport.TLSClientConfig.InsecureSkipVerify = true // #nosec G402
+	}
+}
+
+// IsSSLDisabled returns true if the service is configured to skip the verification of server
+// certificates, looking through the transports installed by this package.
+func IsSSLDisabled(service *core.BaseService) bool {
+	if transport := baseTransport(service.GetHTTPClient()); transport != nil && transport.TLSClientConfig != nil {
+		return transport.TLSClientConfig.InsecureSkipVerify
+	}
+	return false
+}
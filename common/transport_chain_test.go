@@ -0,0 +1,113 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestService(t *testing.T) *core.BaseService {
+	service, err := core.NewBaseService(&core.ServiceOptions{
+		URL:           "https://cd.example.com",
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	assert.Nil(t, err)
+	return service
+}
+
+func TestInstallTransportOnClone(t *testing.T) {
+	service := newTestService(t)
+	service.EnableRetries(3, 0)
+	clone := service.Clone()
+
+	EnableDebugLogging(clone, nil)
+	assert.IsType(t, &DebugLogTransport{}, clone.GetHTTPClient().Transport)
+	assert.IsType(t, &http.Transport{}, service.GetHTTPClient().Transport)
+
+	// The clone was given its own retryable client.
+	assert.NotSame(t, service.Client, clone.Client)
+
+	DisableDebugLogging(clone)
+	assert.IsType(t, &http.Transport{}, clone.GetHTTPClient().Transport)
+
+	EnableDebugLogging(service, nil)
+	EnableDebugLogging(service, core.GetLogger())
+	transport, ok := service.GetHTTPClient().Transport.(*DebugLogTransport)
+	assert.True(t, ok)
+	assert.IsType(t, &http.Transport{}, transport.Next)
+	assert.IsType(t, &http.Transport{}, clone.GetHTTPClient().Transport)
+}
+
+func TestDisableSSLVerification(t *testing.T) {
+	service := newTestService(t)
+	EnableDebugLogging(service, nil)
+	assert.False(t, IsSSLDisabled(service))
+
+	// The core implementation does not see through the installed transports.
+	service.DisableSSLVerification()
+	assert.False(t, IsSSLDisabled(service))
+
+	DisableSSLVerification(service)
+	assert.True(t, IsSSLDisabled(service))
+	DisableDebugLogging(service)
+	assert.True(t, service.IsSSLDisabled())
+}
+
+func TestTransportChainOrder(t *testing.T) {
+	service := newTestService(t)
+	service.EnableRetries(3, 0)
+	clone := service.Clone()
+
+	limiter := NewRateLimiter(nil)
+	EnableDebugLogging(clone, nil)
+	EnableRateLimiting(clone, limiter)
+	EnableDebugLogging(clone, core.GetLogger())
+	rateLimitTransport, ok := clone.GetHTTPClient().Transport.(*RateLimitTransport)
+	assert.True(t, ok)
+	assert.Same(t, limiter, rateLimitTransport.Limiter)
+	debugTransport, ok := rateLimitTransport.Next.(*DebugLogTransport)
+	assert.True(t, ok)
+	assert.NotNil(t, debugTransport.Logger)
+	assert.IsType(t, &http.Transport{}, debugTransport.Next)
+	assert.IsType(t, &http.Transport{}, service.GetHTTPClient().Transport)
+
+	EnableResponseCaching(clone, NewResponseCache(nil), nil)
+	cacheTransport, ok := clone.GetHTTPClient().Transport.(*ResponseCacheTransport)
+	assert.True(t, ok)
+	assert.IsType(t, &RateLimitTransport{}, cacheTransport.Next)
+	assert.IsType(t, &http.Transport{}, service.GetHTTPClient().Transport)
+
+	DisableRateLimiting(clone)
+	DisableResponseCaching(clone)
+	assert.IsType(t, &DebugLogTransport{}, clone.GetHTTPClient().Transport)
+}
+
+func TestConfigureServiceDisablesSSL(t *testing.T) {
+	t.Setenv("CD_TEST_DISABLE_SSL", "true")
+	service := newTestService(t)
+	EnableRateLimiting(service, NewRateLimiter(nil))
+
+	assert.Nil(t, service.ConfigureService("cd_test"))
+	assert.False(t, IsSSLDisabled(service))
+
+	assert.Nil(t, ConfigureService(service, "cd_test"))
+	assert.True(t, IsSSLDisabled(service))
+}